@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/db"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// DataSource returns a schema with the function to read cloud database resource.
+func DataSource() *schema.Resource {
+	recordSchema := databaseDataSourceSchema()
+
+	return &schema.Resource{
+		ReadContext: readDataSource,
+		Schema:      recordSchema,
+	}
+}
+
+// readDataSource calls the GoSH client to set the cloud database schema.
+func readDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	resp, err := db.New(conf.Client).Get(ctx, db.GetRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Database:   fmt.Sprint(d.Get("name")),
+	})
+	if err != nil {
+		return diag.Errorf("Error retrieving database: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving database: %s", resp.Msg)
+	}
+
+	d.SetId(resp.Database.DBName)
+
+	grants := make([]map[string]any, len(resp.Database.Grants))
+	for i, g := range resp.Database.Grants {
+		grants[i] = map[string]any{
+			"username": g.Username,
+			"grants":   g.Grants,
+		}
+	}
+
+	attributes := map[string]any{
+		"container":    resp.Database.Container,
+		"server_label": resp.Database.ServerLabel,
+		"server_ip":    resp.Database.ServerIP,
+		"grants":       grants,
+		"date_added":   resp.Database.DateAdded,
+		"date_updated": resp.Database.DateUpdated,
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/db/grant"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/db/user"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// GrantResource returns a schema with the operations for cloud database grant resource.
+func GrantResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createGrantResource,
+		ReadContext:   readGrantResource,
+		UpdateContext: updateGrantResource,
+		DeleteContext: deleteGrantResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGrantResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		Schema: resourceGrantSchema,
+	}
+}
+
+// createGrantResource is a function to grant privileges on a cloud database to a user.
+func createGrantResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := grant.New(conf.Client).Add(ctx, grant.AddRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   fmt.Sprint(d.Get("username")),
+		Database:   fmt.Sprint(d.Get("database")),
+		Grants:     grantList(d),
+	})
+	if err != nil {
+		return diag.Errorf("Error creating database grant: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error creating database grant: %s", res.Msg)
+	}
+
+	d.SetId(grantID(d))
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return helper.JobDiagnostics("Error creating database grant", err)
+	}
+
+	log.Printf("[INFO] Database Grant: %s", d.Id())
+
+	return readGrantResource(ctx, d, meta)
+}
+
+// readGrantResource is a function to read the privileges of a user on a cloud database.
+func readGrantResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	resp, err := user.New(conf.Client).Get(ctx, user.GetRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   fmt.Sprint(d.Get("username")),
+	})
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Database user for grant (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving database grant: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving database grant: %s", resp.Msg)
+	}
+
+	database := fmt.Sprint(d.Get("database"))
+	for _, g := range resp.User.Grants {
+		if g.DBName == database {
+			if err := d.Set("grants", g.Grants); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Database grant (%s) not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// updateGrantResource is a function to update the privileges of a user on a cloud database.
+func updateGrantResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := grant.New(conf.Client).Update(ctx, grant.UpdateRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   fmt.Sprint(d.Get("username")),
+		Database:   fmt.Sprint(d.Get("database")),
+		Grants:     grantList(d),
+	})
+	if err != nil {
+		return diag.Errorf("Error updating database grant: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error updating database grant: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return helper.JobDiagnostics("Error updating database grant", err)
+	}
+
+	return readGrantResource(ctx, d, meta)
+}
+
+// deleteGrantResource is a function to revoke the privileges of a user on a cloud database.
+func deleteGrantResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := grant.New(conf.Client).Delete(ctx, grant.DeleteRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   fmt.Sprint(d.Get("username")),
+		Database:   fmt.Sprint(d.Get("database")),
+	})
+	if err != nil {
+		return diag.Errorf("Error deleting database grant: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error deleting database grant: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return helper.JobDiagnostics("Error deleting database grant", err)
+	}
+
+	return nil
+}
+
+// importGrantResource is a function to import a cloud database grant with a `server_name,mysql_host,username,database` ID.
+func importGrantResource(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := setImportID(d, "server_name", "mysql_host", "username", "database"); err != nil {
+		return nil, err
+	}
+
+	d.SetId(grantID(d))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// grantID is a function to build the ID of a cloud database grant.
+func grantID(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s", d.Get("username"), d.Get("database"))
+}
+
+// grantList is a function to get the sorted list of privileges of a cloud database grant.
+func grantList(d *schema.ResourceData) []string {
+	set, ok := d.Get("grants").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	grants := make([]string, 0, set.Len())
+	for _, g := range set.List() {
+		grants = append(grants, fmt.Sprint(g))
+	}
+
+	sort.Strings(grants)
+
+	return grants
+}
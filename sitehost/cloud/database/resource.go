@@ -0,0 +1,193 @@
+// Package database provides the functions to create/get cloud databases, users and grants via SiteHost API.
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/db"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// Resource returns a schema with the operations for cloud database resource.
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createResource,
+		ReadContext:   readResource,
+		UpdateContext: updateResource,
+		DeleteContext: deleteResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		Schema: resourceSchema,
+	}
+}
+
+// createResource is a function to create a new cloud database.
+func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := db.New(conf.Client).Add(ctx, db.AddRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Database:   fmt.Sprint(d.Get("name")),
+		Container:  fmt.Sprint(d.Get("container")),
+	})
+	if err != nil {
+		return diag.Errorf("Error creating database: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error creating database: %s", res.Msg)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("name")))
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return helper.JobDiagnostics("Error creating database", err)
+	}
+
+	log.Printf("[INFO] Database Name: %s", d.Id())
+
+	return readResource(ctx, d, meta)
+}
+
+// readResource is a function to read a cloud database.
+func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	resp, err := db.New(conf.Client).Get(ctx, db.GetRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Database:   d.Id(),
+	})
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Database (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving database: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving database: %s", resp.Msg)
+	}
+
+	attributes := map[string]any{
+		"server_name":  resp.Database.ServerName,
+		"mysql_host":   resp.Database.MySQLHost,
+		"name":         resp.Database.DBName,
+		"container":    resp.Database.Container,
+		"server_label": resp.Database.ServerLabel,
+		"date_added":   resp.Database.DateAdded,
+		"date_updated": resp.Database.DateUpdated,
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// updateResource is a function to update the backup container of a cloud database.
+func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	if d.HasChange("container") {
+		res, err := db.New(conf.Client).Update(ctx, db.UpdateRequest{
+			ServerName: fmt.Sprint(d.Get("server_name")),
+			MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+			Database:   d.Id(),
+			Container:  fmt.Sprint(d.Get("container")),
+		})
+		if err != nil {
+			return diag.Errorf("Error updating database: %s", err)
+		}
+
+		if !res.Status {
+			return diag.Errorf("Error updating database: %s", res.Msg)
+		}
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+// deleteResource is a function to delete a cloud database.
+func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := db.New(conf.Client).Delete(ctx, db.DeleteRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Database:   d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("Error deleting database: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error deleting database: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return helper.JobDiagnostics("Error deleting database", err)
+	}
+
+	return nil
+}
+
+// importResource is a function to import a cloud database with a `server_name,mysql_host,name` ID.
+func importResource(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := setImportID(d, "server_name", "mysql_host", "name"); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setImportID is a function to split a comma separated import ID into the given attributes.
+// The last part becomes the resource ID.
+func setImportID(d *schema.ResourceData, attributes ...string) error {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != len(attributes) {
+		return fmt.Errorf("invalid import ID %q, expected %s", d.Id(), strings.Join(attributes, ","))
+	}
+
+	for i, attribute := range attributes {
+		if s[i] == "" {
+			return fmt.Errorf("invalid import ID %q, %s is empty", d.Id(), attribute)
+		}
+
+		if err := d.Set(attribute, s[i]); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(s[len(s)-1])
+
+	return nil
+}
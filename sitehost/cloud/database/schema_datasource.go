@@ -0,0 +1,69 @@
+package database
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// databaseDataSourceSchema is the schema with values for a cloud database DataSource.
+func databaseDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Cloud Container server.",
+		},
+		"mysql_host": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The database host (container) of the database.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The database name.",
+		},
+		"container": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The container the database backups are associated with.",
+		},
+		"server_label": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The label of the server the database is on.",
+		},
+		"server_ip": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address of the server the database is on.",
+		},
+		"grants": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"username": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The database user.",
+					},
+					"grants": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The privileges granted to the user.",
+					},
+				},
+			},
+			Description: "The users with privileges on the database.",
+		},
+		"date_added": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date/time when the database was added.",
+		},
+		"date_updated": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date/time when the database was updated.",
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceSchema is the schema with values for a cloud database resource.
+var resourceSchema = map[string]*schema.Schema{
+	"server_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the Cloud Container server.",
+	},
+	"mysql_host": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database host (container) the database is created on.",
+	},
+	"name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database name.",
+	},
+	"container": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The container the database backups are associated with.",
+	},
+	"server_label": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The label of the server the database is on.",
+	},
+	"date_added": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the database was added.",
+	},
+	"date_updated": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the database was updated.",
+	},
+}
+
+// resourceUserSchema is the schema with values for a cloud database user resource.
+var resourceUserSchema = map[string]*schema.Schema{
+	"server_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the Cloud Container server.",
+	},
+	"mysql_host": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database host (container) the user is created on.",
+	},
+	"username": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database username.",
+	},
+	"password": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringLenBetween(8, 64),
+		Description:  "The password of the database user. A random password is generated when it is not set.",
+	},
+	"date_added": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the user was added.",
+	},
+	"date_updated": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the user was updated.",
+	},
+}
+
+// resourceGrantSchema is the schema with values for a cloud database grant resource.
+var resourceGrantSchema = map[string]*schema.Schema{
+	"server_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the Cloud Container server.",
+	},
+	"mysql_host": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database host (container) of the database and user.",
+	},
+	"username": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database user to grant the privileges to.",
+	},
+	"database": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The database to grant the privileges on.",
+	},
+	"grants": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The privileges granted to the user on the database, e.g. `select`, `insert`, `update`.",
+	},
+}
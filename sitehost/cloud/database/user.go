@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/db/user"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+const (
+	// passwordLength is the length of a generated database user password.
+	passwordLength = 32
+	// passwordCharacters are the characters used in a generated database user password.
+	passwordCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// UserResource returns a schema with the operations for cloud database user resource.
+func UserResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createUserResource,
+		ReadContext:   readUserResource,
+		UpdateContext: updateUserResource,
+		DeleteContext: deleteUserResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importUserResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		Schema: resourceUserSchema,
+	}
+}
+
+// createUserResource is a function to create a new cloud database user.
+func createUserResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	password := fmt.Sprint(d.Get("password"))
+	if password == "" {
+		var err error
+		if password, err = generatePassword(); err != nil {
+			return diag.Errorf("Error generating password: %s", err)
+		}
+	}
+
+	res, err := user.New(conf.Client).Add(ctx, user.AddRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   fmt.Sprint(d.Get("username")),
+		Password:   password,
+	})
+	if err != nil {
+		return diag.Errorf("Error creating database user: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error creating database user: %s", res.Msg)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("username")))
+	if err := d.Set("password", password); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return helper.JobDiagnostics("Error creating database user", err)
+	}
+
+	log.Printf("[INFO] Database User: %s", d.Id())
+
+	return readUserResource(ctx, d, meta)
+}
+
+// readUserResource is a function to read a cloud database user.
+func readUserResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	resp, err := user.New(conf.Client).Get(ctx, user.GetRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   d.Id(),
+	})
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Database user (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving database user: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving database user: %s", resp.Msg)
+	}
+
+	// The password is not returned by the API, so it is kept as it is in the state.
+	attributes := map[string]any{
+		"server_name":  resp.User.ServerName,
+		"mysql_host":   resp.User.MysqlHost,
+		"username":     resp.User.Username,
+		"date_added":   resp.User.DateAdded,
+		"date_updated": resp.User.DateUpdated,
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// updateUserResource is a function to change the password of a cloud database user.
+func updateUserResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	if d.HasChange("password") {
+		res, err := user.New(conf.Client).Update(ctx, user.UpdateRequest{
+			ServerName: fmt.Sprint(d.Get("server_name")),
+			MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+			Username:   d.Id(),
+			Password:   fmt.Sprint(d.Get("password")),
+		})
+		if err != nil {
+			return diag.Errorf("Error updating database user: %s", err)
+		}
+
+		if !res.Status {
+			return diag.Errorf("Error updating database user: %s", res.Msg)
+		}
+
+		if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return helper.JobDiagnostics("Error updating database user", err)
+		}
+	}
+
+	return readUserResource(ctx, d, meta)
+}
+
+// deleteUserResource is a function to delete a cloud database user.
+func deleteUserResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	res, err := user.New(conf.Client).Delete(ctx, user.DeleteRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		MySQLHost:  fmt.Sprint(d.Get("mysql_host")),
+		Username:   d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("Error deleting database user: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error deleting database user: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return helper.JobDiagnostics("Error deleting database user", err)
+	}
+
+	return nil
+}
+
+// importUserResource is a function to import a cloud database user with a `server_name,mysql_host,username` ID.
+func importUserResource(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := setImportID(d, "server_name", "mysql_host", "username"); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// generatePassword is a function to generate a random database user password.
+func generatePassword() (string, error) {
+	limit := big.NewInt(int64(len(passwordCharacters)))
+
+	b := make([]byte, passwordLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+		b[i] = passwordCharacters[n.Int64()]
+	}
+
+	return string(b), nil
+}
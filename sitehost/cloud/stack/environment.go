@@ -0,0 +1,190 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// EnvironmentResource returns a schema with the operations for cloud stack environment resource.
+func EnvironmentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createEnvironmentResource,
+		ReadContext:   readEnvironmentResource,
+		UpdateContext: updateEnvironmentResource,
+		DeleteContext: deleteEnvironmentResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvironmentResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		Schema: resourceEnvironmentSchema,
+	}
+}
+
+// createEnvironmentResource is a function to set the environment variables of a cloud stack.
+func createEnvironmentResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	vars, err := managedVariables(d.Get("variables"), d.Get("sensitive_variables"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(d.Get("name")))
+
+	if diags := updateEnvironment(ctx, conf, d, environmentVariables(nil, vars), true, d.Timeout(schema.TimeoutCreate)); diags != nil {
+		return diags
+	}
+
+	log.Printf("[INFO] Stack Environment: %s", d.Id())
+
+	return readEnvironmentResource(ctx, d, meta)
+}
+
+// readEnvironmentResource is a function to read the managed environment variables of a cloud stack.
+func readEnvironmentResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	vars, err := getEnvironment(ctx, conf, fmt.Sprint(d.Get("server_name")), d.Id())
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, removing environment from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving stack environment: %s", err)
+	}
+
+	stateVars, _ := d.Get("variables").(map[string]any)
+	stateSecrets, _ := d.Get("sensitive_variables").(map[string]any)
+
+	// Only the variables in the state are managed, others on the stack are left alone.
+	plain := make(map[string]string)
+	secrets := make(map[string]string)
+	for name, content := range vars {
+		if _, ok := stateSecrets[name]; ok {
+			secrets[name] = content
+			continue
+		}
+
+		if _, ok := stateVars[name]; ok {
+			plain[name] = content
+		}
+	}
+
+	if err := d.Set("name", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("variables", plain); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("sensitive_variables", secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// updateEnvironmentResource is a function to update the managed environment variables of a cloud stack.
+func updateEnvironmentResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	oldPlain, plain := d.GetChange("variables")
+	oldSecrets, secrets := d.GetChange("sensitive_variables")
+
+	old, err := managedVariables(oldPlain, oldSecrets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, err := managedVariables(plain, secrets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updateEnvironment(ctx, conf, d, environmentVariables(old, current), true, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+		return diags
+	}
+
+	return readEnvironmentResource(ctx, d, meta)
+}
+
+// deleteEnvironmentResource is a function to remove the managed environment variables from a cloud stack.
+func deleteEnvironmentResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	old, err := managedVariables(d.Get("variables"), d.Get("sensitive_variables"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return updateEnvironment(ctx, conf, d, environmentVariables(old, nil), true, d.Timeout(schema.TimeoutDelete))
+}
+
+// importEnvironmentResource is a function to import the environment of a cloud stack with a `server_name,name` ID.
+// All the variables of the stack are adopted as sensitive variables, so no values show in plans.
+func importEnvironmentResource(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert meta object")
+	}
+
+	if _, err := importResource(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	vars, err := getEnvironment(ctx, conf, fmt.Sprint(d.Get("server_name")), d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving stack environment: %w", err)
+	}
+
+	if err := d.Set("sensitive_variables", vars); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// managedVariables is a function to merge the plain and sensitive variables of a cloud stack environment.
+func managedVariables(plain any, sensitive any) (map[string]any, error) {
+	vars := make(map[string]any)
+
+	if m, ok := plain.(map[string]any); ok {
+		for name, content := range m {
+			vars[name] = content
+		}
+	}
+
+	if m, ok := sensitive.(map[string]any); ok {
+		for name, content := range m {
+			if _, ok := vars[name]; ok {
+				return nil, fmt.Errorf("environment variable %q is set in both variables and sensitive_variables", name)
+			}
+			vars[name] = content
+		}
+	}
+
+	return vars, nil
+}
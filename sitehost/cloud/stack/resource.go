@@ -0,0 +1,441 @@
+package stack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/stack"
+	"github.com/sitehostnz/gosh/pkg/api/cloud/stack/environment"
+	"github.com/sitehostnz/gosh/pkg/models"
+	"github.com/sitehostnz/gosh/pkg/utils"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+	"gopkg.in/yaml.v3"
+)
+
+// Resource returns a schema with the operations for cloud stack resource.
+func Resource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createResource,
+		ReadContext:   readResource,
+		UpdateContext: updateResource,
+		DeleteContext: deleteResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: importStackResource,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		CustomizeDiff: preventReplace,
+		Schema:        resourceSchema,
+		Description: "Provides a SiteHost Cloud Container stack. The SiteHost API cannot delete stacks, so destroying or " +
+			"replacing a stack requires `stop_on_destroy`, which stops the stack and leaves it to be deleted in CP.",
+	}
+}
+
+// createResource is a function to create a new cloud stack.
+func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	client := stack.New(conf.Client)
+
+	name, diags := stackName(ctx, client, d)
+	if diags != nil {
+		return diags
+	}
+
+	enableSSL, ok := d.Get("enable_ssl").(bool)
+	if !ok {
+		return diag.Errorf("failed to convert enable_ssl to bool")
+	}
+
+	res, err := client.Add(ctx, stack.AddRequest{
+		ServerName:           fmt.Sprint(d.Get("server_name")),
+		Name:                 name,
+		Label:                fmt.Sprint(d.Get("label")),
+		EnableSSL:            utils.BoolToInt(enableSSL),
+		DockerCompose:        fmt.Sprint(d.Get("docker_compose")),
+		EnvironmentVariables: environmentVariables(nil, d.Get("environment_variables")),
+	})
+	if err != nil {
+		return diag.Errorf("Error creating stack: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error creating stack: %s", res.Msg)
+	}
+
+	d.SetId(name)
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return helper.JobDiagnostics("Error creating stack", err)
+	}
+
+	log.Printf("[INFO] Stack Name: %s", d.Id())
+
+	if enabled, ok := d.Get("enabled").(bool); ok && !enabled {
+		if diags := stopStartStack(ctx, conf, d, client.Stop, d.Timeout(schema.TimeoutCreate)); diags != nil {
+			return diags
+		}
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+// stackName is a function to get the configured name of a new cloud stack, or generate one when it is not set.
+func stackName(ctx context.Context, client *stack.Client, d *schema.ResourceData) (string, diag.Diagnostics) {
+	if name := fmt.Sprint(d.Get("name")); name != "" {
+		return name, nil
+	}
+
+	res, err := client.GenerateName(ctx)
+	if err != nil {
+		return "", diag.Errorf("Error generating stack name: %s", err)
+	}
+
+	if !res.Status {
+		return "", diag.Errorf("Error generating stack name: %s", res.Msg)
+	}
+
+	return res.Return.Name, nil
+}
+
+// readResource is a function to read a cloud stack.
+func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	serverName := fmt.Sprint(d.Get("server_name"))
+
+	resp, err := stack.New(conf.Client).Get(ctx, stack.GetRequest{
+		ServerName: serverName,
+		Name:       d.Id(),
+	})
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Stack (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving stack: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving stack: %s", resp.Msg)
+	}
+
+	attributes := map[string]any{
+		"name":         resp.Stack.Name,
+		"label":        resp.Stack.Label,
+		"server_name":  resp.Stack.Server,
+		"server_label": resp.Stack.ServerLabel,
+		"date_added":   resp.Stack.DateAdded,
+		"date_updated": resp.Stack.DateUpdated,
+	}
+
+	addContainerAttributes(attributes, resp.Stack.Containers)
+
+	// The API may reformat the compose file, only fill it in when importing.
+	if d.Get("docker_compose") == "" {
+		attributes["docker_compose"] = resp.Stack.DockerFile
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	vars, err := getEnvironment(ctx, conf, serverName, d.Id())
+	if err != nil {
+		return diag.Errorf("Error retrieving stack environment: %s", err)
+	}
+
+	if err := d.Set("environment_variables", vars); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// addContainerAttributes is a function to add the enable_ssl and enabled attributes of a stack from its containers.
+// The stack is enabled while any of its containers runs, a stack without containers leaves both unchanged.
+func addContainerAttributes(attributes map[string]any, containers []models.Container) {
+	if len(containers) == 0 {
+		return
+	}
+
+	enableSSL, enabled := false, false
+	for _, c := range containers {
+		enableSSL = enableSSL || c.SslEnabled
+		enabled = enabled || strings.EqualFold(c.State, "running")
+	}
+
+	attributes["enable_ssl"] = enableSSL
+	attributes["enabled"] = enabled
+}
+
+// updateResource is a function to update a cloud stack.
+func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	client := stack.New(conf.Client)
+	enabled, _ := d.Get("enabled").(bool)
+
+	if d.HasChange("environment_variables") {
+		old, current := d.GetChange("environment_variables")
+		vars := environmentVariables(old, current)
+		restart := enabled && !d.HasChange("enabled")
+		if diags := updateEnvironment(ctx, conf, d, vars, restart, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("enabled") {
+		action := client.Stop
+		if enabled {
+			action = client.Start
+		}
+
+		if diags := stopStartStack(ctx, conf, d, action, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
+	return readResource(ctx, d, meta)
+}
+
+// deleteResource is a function to delete a cloud stack.
+//
+// The API has no endpoint to delete a stack, so unless stop_on_destroy is set this fails,
+// otherwise the stack is stopped and must be removed in CP.
+func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	if stopOnDestroy, ok := d.Get("stop_on_destroy").(bool); !ok || !stopOnDestroy {
+		return diag.Errorf("Error deleting stack: the SiteHost API cannot delete stacks. Set stop_on_destroy = true "+
+			"and apply it to stop the stack %q on server %q instead, then delete it in the Control Panel.", d.Id(), d.Get("server_name"))
+	}
+
+	if enabled, ok := d.Get("enabled").(bool); !ok || enabled {
+		if diags := stopStartStack(ctx, conf, d, stack.New(conf.Client).Stop, d.Timeout(schema.TimeoutDelete)); diags != nil {
+			return diags
+		}
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Stack stopped but not deleted",
+			Detail: fmt.Sprintf("The SiteHost API cannot delete stacks. The stack %q on server %q was stopped "+
+				"and removed from the Terraform state, delete it in the Control Panel.", d.Id(), d.Get("server_name")),
+		},
+	}
+}
+
+// preventReplace is a function to fail the plan when a stack would be replaced, as the old stack cannot be deleted.
+func preventReplace(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if stopOnDestroy, ok := d.Get("stop_on_destroy").(bool); ok && stopOnDestroy {
+		return nil
+	}
+
+	for _, k := range []string{"server_name", "name", "label", "docker_compose", "enable_ssl"} {
+		if d.HasChange(k) {
+			return fmt.Errorf("changing %s replaces the stack %q, but the SiteHost API cannot delete the old stack. "+
+				"Set stop_on_destroy = true and apply it first to stop the old stack instead", k, d.Id())
+		}
+	}
+
+	return nil
+}
+
+// suppressEquivalentCompose is a function to ignore docker-compose changes that only reformat the YAML,
+// such as the formatting returned by the API for an imported stack.
+func suppressEquivalentCompose(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	if strings.TrimSpace(oldValue) == strings.TrimSpace(newValue) {
+		return true
+	}
+
+	var o, n any
+	if err := yaml.Unmarshal([]byte(oldValue), &o); err != nil {
+		return false
+	}
+
+	if err := yaml.Unmarshal([]byte(newValue), &n); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(o, n)
+}
+
+// importStackResource is a function to import a cloud stack with a `server_name,name` ID.
+func importStackResource(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, err := importResource(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	// Defaults are not applied on import, set it so the first plan does not change it.
+	if err := d.Set("stop_on_destroy", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importResource is a function to import a cloud stack with a `server_name,name` ID.
+func importResource(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected server_name,name", d.Id())
+	}
+
+	d.SetId(s[1])
+	if err := d.Set("server_name", s[0]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// stopStartStack is a function to start or stop a cloud stack and wait for the job.
+func stopStartStack(
+	ctx context.Context,
+	conf *helper.CombinedConfig,
+	d *schema.ResourceData,
+	action func(context.Context, stack.StopStartRestartRequest) (stack.StartStopRestartResponse, error),
+	timeout time.Duration,
+) diag.Diagnostics {
+	res, err := action(ctx, stack.StopStartRestartRequest{
+		ServerName: fmt.Sprint(d.Get("server_name")),
+		Name:       d.Id(),
+	})
+	if err != nil {
+		return diag.Errorf("Error changing stack state: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error changing stack state: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, timeout); err != nil {
+		return helper.JobDiagnostics("Error changing stack state", err)
+	}
+
+	return nil
+}
+
+// getEnvironment is a function to get the environment variables of a cloud stack.
+func getEnvironment(ctx context.Context, conf *helper.CombinedConfig, serverName string, name string) (map[string]string, error) {
+	resp, err := environment.New(conf.Client).Get(ctx, environment.GetRequest{
+		ServerName: serverName,
+		Project:    name,
+		Service:    name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.Status {
+		return nil, errors.New(resp.Msg)
+	}
+
+	vars := make(map[string]string, len(resp.EnvironmentVariables))
+	for _, v := range resp.EnvironmentVariables {
+		vars[v.Name] = v.Content
+	}
+
+	return vars, nil
+}
+
+// updateEnvironment is a function to update the environment variables of a cloud stack.
+// A running stack is restarted so the containers pick up the new values.
+func updateEnvironment(
+	ctx context.Context,
+	conf *helper.CombinedConfig,
+	d *schema.ResourceData,
+	vars []models.EnvironmentVariable,
+	restart bool,
+	timeout time.Duration,
+) diag.Diagnostics {
+	serverName := fmt.Sprint(d.Get("server_name"))
+
+	res, err := environment.New(conf.Client).Update(ctx, environment.UpdateRequest{
+		ServerName:           serverName,
+		Project:              d.Id(),
+		Service:              d.Id(),
+		EnvironmentVariables: vars,
+	})
+	if err != nil {
+		return diag.Errorf("Error updating stack environment: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error updating stack environment: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, timeout); err != nil {
+		return helper.JobDiagnostics("Error updating stack environment", err)
+	}
+
+	if !restart {
+		return nil
+	}
+
+	return stopStartStack(ctx, conf, d, stack.New(conf.Client).Restart, timeout)
+}
+
+// environmentVariables is a function to convert the environment variables maps into a list for the API.
+// Variables only in old are sent without content, which removes them from the stack.
+func environmentVariables(old any, current any) []models.EnvironmentVariable {
+	oldVars, _ := old.(map[string]any)
+	currentVars, _ := current.(map[string]any)
+
+	names := make([]string, 0, len(oldVars)+len(currentVars))
+	for name := range currentVars {
+		names = append(names, name)
+	}
+
+	for name := range oldVars {
+		if _, ok := currentVars[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	vars := make([]models.EnvironmentVariable, 0, len(names))
+	for _, name := range names {
+		content := ""
+		if v, ok := currentVars[name]; ok {
+			content = fmt.Sprint(v)
+		}
+
+		vars = append(vars, models.EnvironmentVariable{Name: name, Content: content})
+	}
+
+	return vars
+}
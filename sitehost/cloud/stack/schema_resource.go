@@ -0,0 +1,118 @@
+package stack
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceSchema is the schema with values for a cloud stack resource.
+var resourceSchema = map[string]*schema.Schema{
+	"server_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the Cloud Container server to deploy the stack on.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The stack name. A name is generated by the API when it is not set.",
+	},
+	"label": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The stack label, displayed in CP.",
+	},
+	"docker_compose": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.NoZeroValues,
+		DiffSuppressFunc: suppressEquivalentCompose,
+		Description: "The docker-compose content of the stack. Changes that only reformat the YAML are ignored, " +
+			"any other change replaces the stack.",
+	},
+	"enable_ssl": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Whether SSL is enabled for the stack.",
+	},
+	"environment_variables": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Computed:    true,
+		Sensitive:   true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The environment variables of the stack. Do not use together with `sitehost_cloud_stack_environment`.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Whether the stack is started or stopped.",
+	},
+	"stop_on_destroy": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "The SiteHost API cannot delete stacks, so destroying or replacing the stack fails unless this " +
+			"is `true`. The stack is then stopped and removed from the state, and must be deleted in CP. " +
+			"The value must be applied before the stack is destroyed.",
+	},
+	"server_label": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The label of the server the stack is running on.",
+	},
+	"date_added": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the stack was added.",
+	},
+	"date_updated": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the stack was updated.",
+	},
+}
+
+// resourceEnvironmentSchema is the schema with values for a cloud stack environment resource.
+var resourceEnvironmentSchema = map[string]*schema.Schema{
+	"server_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The name of the server the stack is running on.",
+	},
+	"name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The stack name.",
+	},
+	"variables": {
+		Type:         schema.TypeMap,
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		AtLeastOneOf: []string{"variables", "sensitive_variables"},
+		Description:  "The environment variables managed on the stack.",
+	},
+	"sensitive_variables": {
+		Type:         schema.TypeMap,
+		Optional:     true,
+		Sensitive:    true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		AtLeastOneOf: []string{"variables", "sensitive_variables"},
+		Description: "The environment variables managed on the stack whose values are hidden from the plan output. " +
+			"An import adopts every variable of the stack here.",
+	},
+}
@@ -0,0 +1,160 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/dns"
+	"github.com/sitehostnz/gosh/pkg/models"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// ZoneDataSource returns a schema with the function to read a DNS Zone.
+func ZoneDataSource() *schema.Resource {
+	recordSchema := zoneDataSourceSchema()
+
+	return &schema.Resource{
+		ReadContext: readZoneDataSource,
+		Schema:      recordSchema,
+	}
+}
+
+// RecordsDataSource returns a schema with the function to read the records of a DNS Zone.
+func RecordsDataSource() *schema.Resource {
+	recordSchema := recordsDataSourceSchema()
+
+	return &schema.Resource{
+		ReadContext: readRecordsDataSource,
+		Schema:      recordSchema,
+	}
+}
+
+// readZoneDataSource is a function to check that a DNS Zone exists and read its name servers.
+func readZoneDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	client := dns.New(conf.Client)
+	domain := fmt.Sprintf("%v", d.Get("name"))
+
+	response, err := client.GetZone(ctx, dns.GetZoneRequest{DomainName: domain})
+	if err != nil {
+		return diag.Errorf("Error retrieving domain: %s", err)
+	}
+
+	if !response.Status {
+		return diag.Errorf("Error retrieving domain: %s", response.Msg)
+	}
+
+	found := false
+	for _, zone := range response.Return {
+		if strings.EqualFold(zone.Name, domain) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return diag.Errorf("Error retrieving domain: %s not found", domain)
+	}
+
+	nameServers, err := zoneNameServers(ctx, client, domain)
+	if err != nil {
+		return diag.Errorf("Error retrieving DNS records: %s", err)
+	}
+
+	d.SetId(domain)
+
+	if err := d.Set("name_servers", nameServers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// zoneNameServers is a function to get the sorted name servers of a DNS Zone from its NS records.
+func zoneNameServers(ctx context.Context, client *dns.Client, domain string) ([]string, error) {
+	records, err := client.ListRecords(ctx, dns.ListRecordsRequest{Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+
+	nameServers := make([]string, 0)
+	for _, record := range records.Return {
+		if record.Type == "NS" && strings.EqualFold(record.Name, domain) {
+			nameServers = append(nameServers, record.Content)
+		}
+	}
+
+	sort.Strings(nameServers)
+
+	return nameServers, nil
+}
+
+// readRecordsDataSource is a function to read the records of a DNS Zone, filtered by name and type.
+func readRecordsDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	domain := fmt.Sprintf("%v", d.Get("domain"))
+
+	name := fmt.Sprintf("%v", d.Get("name"))
+	if name != "" {
+		name = recordName(name, domain)
+	}
+
+	recordType := strings.ToUpper(fmt.Sprintf("%v", d.Get("type")))
+
+	resp, err := dns.New(conf.Client).ListRecords(ctx, dns.ListRecordsRequest{Domain: domain})
+	if err != nil {
+		return diag.Errorf("Error retrieving DNS records: %s", err)
+	}
+
+	records := make([]map[string]interface{}, 0)
+	for _, record := range resp.Return {
+		if !matchRecord(record, name, recordType) {
+			continue
+		}
+
+		ttl, err := strconv.Atoi(record.TTL)
+		if err != nil {
+			ttl = 0
+		}
+
+		records = append(records, map[string]interface{}{
+			"id":          record.ID,
+			"name":        record.Name,
+			"type":        record.Type,
+			"record":      record.Content,
+			"priority":    recordPriority(record),
+			"ttl":         ttl,
+			"change_date": record.ChangeDate,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", domain, name, recordType))
+
+	if err := d.Set("records", records); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// matchRecord is a function to check if a record matches the name and type filters, empty filters match any record.
+func matchRecord(record models.DNSRecord, name, recordType string) bool {
+	if name != "" && !strings.EqualFold(record.Name, name) {
+		return false
+	}
+
+	return recordType == "" || record.Type == recordType
+}
@@ -65,10 +65,20 @@ func readZoneResource(ctx context.Context, d *schema.ResourceData, meta interfac
 	client := dns.New(conf.Client)
 	response, err := client.GetZone(ctx, dns.GetZoneRequest{DomainName: d.Id()})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Domain (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving domain: %s", err)
 	}
 
 	if !response.Status {
+		if helper.IsNotFoundMessage(response.Msg) {
+			log.Printf("[WARN] Domain (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving domain: %s", response.Msg)
 	}
 
@@ -82,7 +92,9 @@ func readZoneResource(ctx context.Context, d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return diag.Errorf("Error finding the domain")
+	log.Printf("[WARN] Domain (%s) not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
 }
 
 // deleteZoneResource is a function to delete a DNS Zone.
@@ -182,9 +194,21 @@ func readRecordResource(ctx context.Context, d *schema.ResourceData, meta interf
 		DomainName: domain,
 	})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Domain (%s) not found, removing DNS record from state", domain)
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving DNS zone: %s", err)
 	}
 
+	// GetRecord returns an empty record when the ID is not in the zone.
+	if resp.ID == "" {
+		log.Printf("[WARN] DNS record (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	if err := setRecordAttributes(d, resp); err != nil {
 		return diag.FromErr(err)
 	}
@@ -258,19 +282,89 @@ func updateRecordResource(ctx context.Context, d *schema.ResourceData, meta inte
 }
 
 // importRecordResource is a function to import a DNS Record.
-func importRecordResource(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	if strings.Contains(d.Id(), ",") {
-		s := strings.Split(d.Id(), ",")
+// The record is either given as `domain/name/type[/content]` and looked up in the zone, or as `domain,recordID`.
+func importRecordResource(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	var domain, id string
+
+	// Content can hold commas, so any ID with a slash is a domain/name/type[/content] ID.
+	if strings.Contains(d.Id(), "/") {
+		s := strings.SplitN(d.Id(), "/", 4)
+		if len(s) < 3 || s[0] == "" || s[1] == "" || s[2] == "" {
+			return nil, fmt.Errorf("invalid import ID %q, expected domain/name/type[/content] or domain,recordID", d.Id())
+		}
 
-		d.SetId(s[1])
-		if err := d.Set("domain", s[0]); err != nil {
+		conf, ok := meta.(*helper.CombinedConfig)
+		if !ok {
+			return nil, fmt.Errorf("failed to convert meta object")
+		}
+
+		record, err := findImportRecord(ctx, dns.New(conf.Client), s)
+		if err != nil {
+			return nil, err
+		}
+
+		domain, id = s[0], record.ID
+	} else {
+		var err error
+		if domain, id, err = splitRecordID(d.Id()); err != nil {
 			return nil, err
 		}
 	}
 
+	d.SetId(id)
+	if err := d.Set("domain", domain); err != nil {
+		return nil, err
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
+// splitRecordID is a function to split a `domain,recordID` import ID.
+func splitRecordID(importID string) (string, string, error) {
+	s := strings.Split(importID, ",")
+	if len(s) != 2 || s[0] == "" || s[1] == "" {
+		return "", "", fmt.Errorf("invalid import ID %q, expected domain,recordID or domain/name/type[/content]", importID)
+	}
+
+	return s[0], s[1], nil
+}
+
+// findImportRecord is a function to find the only record matching the domain, name, type and optional content of an import ID.
+func findImportRecord(ctx context.Context, client *dns.Client, s []string) (models.DNSRecord, error) {
+	domain := s[0]
+	name := recordName(s[1], domain)
+	recordType := strings.ToUpper(s[2])
+
+	resp, err := client.ListRecords(ctx, dns.ListRecordsRequest{Domain: domain})
+	if err != nil {
+		return models.DNSRecord{}, fmt.Errorf("error retrieving DNS records: %w", err)
+	}
+
+	matches := make([]models.DNSRecord, 0)
+	for _, record := range resp.Return {
+		if !strings.EqualFold(record.Name, name) || record.Type != recordType {
+			continue
+		}
+
+		// The API stores content without the trailing dot of a fully qualified name.
+		if len(s) == 4 && record.Content != strings.TrimSuffix(s[3], ".") {
+			continue
+		}
+
+		matches = append(matches, record)
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.DNSRecord{}, fmt.Errorf("no %s record %q found in %s", recordType, name, domain)
+	case 1:
+		return matches[0], nil
+	default:
+		return models.DNSRecord{}, fmt.Errorf("%d %s records %q found in %s, add the content to the import ID: %s/%s/%s/<content>",
+			len(matches), recordType, name, domain, domain, s[1], s[2])
+	}
+}
+
 // setRecordAttributes is a function to set the attributes of a DNS Record.
 func setRecordAttributes(d *schema.ResourceData, record models.DNSRecord) error {
 	d.SetId(record.ID)
@@ -300,5 +394,14 @@ func setRecordAttributes(d *schema.ResourceData, record models.DNSRecord) error
 		return err
 	}
 
+	ttl, err := strconv.Atoi(record.TTL)
+	if err != nil {
+		ttl = 0
+	}
+
+	if err := d.Set("ttl", ttl); err != nil {
+		return err
+	}
+
 	return d.Set("change_date", record.ChangeDate)
 }
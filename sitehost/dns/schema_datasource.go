@@ -0,0 +1,109 @@
+package dns
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// zoneFileDataSourceSchema is the schema with values for a DNS zone file DataSource.
+func zoneFileDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domain": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "The base domain",
+		},
+		"content": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The records of the zone as a RFC 1035 zone file",
+		},
+	}
+}
+
+// zoneDataSourceSchema is the schema with values for a DNS zone DataSource.
+func zoneDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "The domain name",
+		},
+		"name_servers": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The name servers of the domain, from its NS records",
+		},
+	}
+}
+
+// recordsDataSourceSchema is the schema with values for a DNS records DataSource.
+func recordsDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"domain": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "The base domain",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Only return the records with this name, either fully qualified, relative to the domain or `@`",
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.NoZeroValues,
+			Description:  "Only return the records of this type",
+		},
+		"records": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The record ID",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The subdomain",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The record type",
+					},
+					"record": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The record content",
+					},
+					"priority": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The priority type",
+					},
+					"ttl": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The time to live of the record in seconds",
+					},
+					"change_date": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date/time when the record was changed",
+					},
+				},
+			},
+			Description: "The records of the zone matching the filters",
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package dns
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -61,8 +63,102 @@ var resourceRecordSchema = map[string]*schema.Schema{
 		Optional: true,
 	},
 
+	"ttl": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The time to live of the record in seconds, set by SiteHost",
+	},
+
 	"change_date": {
 		Type:     schema.TypeString,
 		Computed: true,
 	},
 }
+
+// resourceZoneRecordsSchema is the schema with values for the authoritative DNS zone records resource.
+var resourceZoneRecordsSchema = map[string]*schema.Schema{
+	"domain": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The base domain",
+	},
+
+	"manage_ns_soa": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether the SOA record and the NS records of the zone apex are managed too, by default they are left untouched. NS records delegating a subdomain are always managed",
+	},
+
+	"record": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.NoZeroValues,
+					Description:  "The record name, relative to the domain or fully qualified, and `@` for the domain itself. The API stores names fully qualified",
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"A",
+						"AAAA",
+						"CAA",
+						"CNAME",
+						"MX",
+						"NS",
+						"SOA",
+						"TXT",
+						"SRV",
+					}, false),
+					Description: "The record type, SOA and NS records of the zone apex require `manage_ns_soa`",
+				},
+
+				"content": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringDoesNotMatch(
+						regexp.MustCompile(`\.$`), "must not end with a dot, it is removed by the API",
+					),
+					Description: "The record content",
+				},
+
+				"priority": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 65535),
+					Description:  "The priority type",
+				},
+			},
+		},
+		Description: "The complete set of records of the zone, any other record is deleted",
+	},
+}
+
+// resourceZoneFileSchema is the schema with values for the DNS zone file resource.
+var resourceZoneFileSchema = map[string]*schema.Schema{
+	"domain": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description:  "The base domain",
+	},
+
+	"content": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+		Description: "The RFC 1035 zone file with the complete set of records of the zone, any other record is deleted. " +
+			"The SOA record, the NS records of the zone apex and TTLs are ignored, they are managed by SiteHost. " +
+			"NS records delegating a subdomain are loaded like any other record",
+	},
+}
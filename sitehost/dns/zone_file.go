@@ -0,0 +1,192 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/dns"
+	"github.com/sitehostnz/gosh/pkg/models"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// ZoneFileResource returns a schema with the operations for the DNS zone file resource.
+func ZoneFileResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createZoneFileResource,
+		ReadContext:   readZoneFileResource,
+		UpdateContext: updateZoneFileResource,
+		DeleteContext: deleteZoneFileResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: validateZoneFile,
+		Schema:        resourceZoneFileSchema,
+	}
+}
+
+// ZoneFileDataSource returns a schema with the function to render a DNS Zone as a zone file.
+func ZoneFileDataSource() *schema.Resource {
+	recordSchema := zoneFileDataSourceSchema()
+
+	return &schema.Resource{
+		ReadContext: readZoneFileDataSource,
+		Schema:      recordSchema,
+	}
+}
+
+// validateZoneFile is a function to parse the zone file at plan time, so errors show before anything is changed.
+func validateZoneFile(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("domain") || !d.NewValueKnown("content") {
+		return nil
+	}
+
+	_, err := zoneFileRecords(fmt.Sprintf("%v", d.Get("content")), fmt.Sprintf("%v", d.Get("domain")))
+
+	return err
+}
+
+// createZoneFileResource is a function to load a zone file into a DNS Zone.
+func createZoneFileResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%v", d.Get("domain")))
+
+	if diags := updateZoneFileResource(ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("[INFO] Domain Zone File: %s", d.Id())
+
+	return nil
+}
+
+// readZoneFileResource is a function to read the records of a DNS Zone back.
+// The configured zone file is kept while the zone holds the same records, otherwise the zone is rendered as a zone file.
+func readZoneFileResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	current, err := listZoneRecords(ctx, dns.New(conf.Client), d.Id(), false)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Domain (%s) not found, removing zone file from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving DNS records: %s", err)
+	}
+
+	content := fmt.Sprintf("%v", d.Get("content"))
+	if desired, err := zoneFileRecords(content, d.Id()); err != nil || !sameRecordSet(desired, current) {
+		content = renderZoneFile(d.Id(), current)
+	}
+
+	if err := d.Set("domain", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("content", content); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// updateZoneFileResource is a function to converge the records of a DNS Zone to the zone file.
+func updateZoneFileResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	desired, err := zoneFileRecords(fmt.Sprintf("%v", d.Get("content")), d.Id())
+	if err != nil {
+		return diag.Errorf("Error parsing zone file: %s", err)
+	}
+
+	if err := syncZoneRecords(ctx, dns.New(conf.Client), d.Id(), desired, false); err != nil {
+		return diag.Errorf("Error updating DNS records: %s", err)
+	}
+
+	return readZoneFileResource(ctx, d, meta)
+}
+
+// deleteZoneFileResource is a function to delete the records of a DNS Zone loaded from a zone file.
+func deleteZoneFileResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	if err := syncZoneRecords(ctx, dns.New(conf.Client), d.Id(), nil, false); err != nil {
+		return diag.Errorf("Error deleting DNS records: %s", err)
+	}
+
+	return nil
+}
+
+// readZoneFileDataSource is a function to render all the records of a DNS Zone as a zone file.
+func readZoneFileDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	domain := fmt.Sprintf("%v", d.Get("domain"))
+
+	records, err := listZoneRecords(ctx, dns.New(conf.Client), domain, true)
+	if err != nil {
+		return diag.Errorf("Error retrieving DNS records: %s", err)
+	}
+
+	d.SetId(domain)
+
+	if err := d.Set("content", renderZoneFile(domain, records)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// zoneFileRecords is a function to parse a zone file into the records to manage, leaving out the apex NS and SOA records.
+// NS records delegating a subdomain are kept.
+func zoneFileRecords(content, domain string) ([]models.DNSRecord, error) {
+	parsed, err := parseZoneFile(content, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]models.DNSRecord, 0, len(parsed))
+	for _, record := range parsed {
+		if isApexNSOrSOA(record, domain) {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// sameRecordSet is a function to check if two lists hold the same records, in any order.
+func sameRecordSet(a, b []models.DNSRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	rest := make([]models.DNSRecord, len(b))
+	copy(rest, b)
+
+	for _, record := range a {
+		i := findRecord(rest, record, sameRecord)
+		if i < 0 {
+			return false
+		}
+		rest = append(rest[:i], rest[i+1:]...)
+	}
+
+	return true
+}
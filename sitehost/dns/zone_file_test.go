@@ -0,0 +1,32 @@
+package dns
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sitehostnz/gosh/pkg/models"
+)
+
+func TestZoneFileRecords(t *testing.T) {
+	t.Parallel()
+
+	content := "$ORIGIN example.com.\n" +
+		"@ IN SOA ns1.example.com. hostmaster.example.com. 1 3600 600 86400 300\n" +
+		"@ IN NS ns1.example.com.\n" +
+		"sub IN NS ns1.other.net.\n" +
+		"www IN A 192.0.2.1\n"
+
+	got, err := zoneFileRecords(content, "example.com")
+	if err != nil {
+		t.Fatalf("zoneFileRecords() error = %v", err)
+	}
+
+	want := []models.DNSRecord{
+		exampleRecord("sub.example.com", "NS", "ns1.other.net", "0"),
+		exampleRecord("www.example.com", "A", "192.0.2.1", "0"),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("zoneFileRecords() = %+v, want %+v", got, want)
+	}
+}
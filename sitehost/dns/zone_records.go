@@ -0,0 +1,357 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/dns"
+	"github.com/sitehostnz/gosh/pkg/models"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
+)
+
+// ZoneRecordsResource returns a schema with the operations for the authoritative DNS zone records resource.
+func ZoneRecordsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createZoneRecordsResource,
+		ReadContext:   readZoneRecordsResource,
+		UpdateContext: updateZoneRecordsResource,
+		DeleteContext: deleteZoneRecordsResource,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceZoneRecordsSchema,
+	}
+}
+
+// createZoneRecordsResource is a function to take over the records of a DNS Zone.
+func createZoneRecordsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(fmt.Sprintf("%v", d.Get("domain")))
+
+	if diags := updateZoneRecordsResource(ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	log.Printf("[INFO] Domain Records: %s", d.Id())
+
+	return nil
+}
+
+// readZoneRecordsResource is a function to read the records of a DNS Zone.
+func readZoneRecordsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	manageNSSOA, ok := d.Get("manage_ns_soa").(bool)
+	if !ok {
+		return diag.Errorf("failed to convert manage_ns_soa to bool")
+	}
+
+	records, err := listZoneRecords(ctx, dns.New(conf.Client), d.Id(), manageNSSOA)
+	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Domain (%s) not found, removing DNS records from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving DNS records: %s", err)
+	}
+
+	names := configuredNames(d)
+
+	set := make([]interface{}, len(records))
+	for i, record := range records {
+		name, ok := names[strings.ToLower(record.Name)]
+		if !ok {
+			name = record.Name
+		}
+
+		set[i] = map[string]interface{}{
+			"name":     name,
+			"type":     record.Type,
+			"content":  record.Content,
+			"priority": recordPriority(record),
+		}
+	}
+
+	if err := d.Set("domain", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("record", set); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// configuredNames is a function to map the fully qualified record names to the names as written in the configuration.
+// The API returns names fully qualified, so they are read back the way they were configured.
+func configuredNames(d *schema.ResourceData) map[string]string {
+	names := make(map[string]string)
+
+	set, ok := d.Get("record").(*schema.Set)
+	if !ok {
+		return names
+	}
+
+	for _, v := range set.List() {
+		if m, ok := v.(map[string]interface{}); ok {
+			name := fmt.Sprintf("%v", m["name"])
+			names[strings.ToLower(recordName(name, d.Id()))] = name
+		}
+	}
+
+	return names
+}
+
+// updateZoneRecordsResource is a function to converge the records of a DNS Zone to the configured set.
+func updateZoneRecordsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	manageNSSOA, ok := d.Get("manage_ns_soa").(bool)
+	if !ok {
+		return diag.Errorf("failed to convert manage_ns_soa to bool")
+	}
+
+	set, ok := d.Get("record").(*schema.Set)
+	if !ok {
+		return diag.Errorf("failed to convert record object")
+	}
+
+	desired := make([]models.DNSRecord, 0, set.Len())
+	for _, v := range set.List() {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return diag.Errorf("failed to convert record object")
+		}
+
+		record := models.DNSRecord{
+			Domain:   d.Id(),
+			Name:     recordName(fmt.Sprintf("%v", m["name"]), d.Id()),
+			Type:     fmt.Sprintf("%v", m["type"]),
+			Content:  fmt.Sprintf("%v", m["content"]),
+			Priority: fmt.Sprintf("%v", m["priority"]),
+		}
+
+		if !manageNSSOA && isApexNSOrSOA(record, d.Id()) {
+			return diag.Errorf("Error updating DNS records: %s record %q requires manage_ns_soa", record.Type, record.Name)
+		}
+
+		desired = append(desired, record)
+	}
+
+	if err := syncZoneRecords(ctx, dns.New(conf.Client), d.Id(), desired, manageNSSOA); err != nil {
+		return diag.Errorf("Error updating DNS records: %s", err)
+	}
+
+	return readZoneRecordsResource(ctx, d, meta)
+}
+
+// deleteZoneRecordsResource is a function to delete all the managed records of a DNS Zone.
+func deleteZoneRecordsResource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	manageNSSOA, ok := d.Get("manage_ns_soa").(bool)
+	if !ok {
+		return diag.Errorf("failed to convert manage_ns_soa to bool")
+	}
+
+	if err := syncZoneRecords(ctx, dns.New(conf.Client), d.Id(), nil, manageNSSOA); err != nil {
+		return diag.Errorf("Error deleting DNS records: %s", err)
+	}
+
+	return nil
+}
+
+// listZoneRecords is a function to list the records of a DNS Zone, leaving out the apex NS and SOA records unless they are managed.
+func listZoneRecords(ctx context.Context, client *dns.Client, domain string, manageNSSOA bool) ([]models.DNSRecord, error) {
+	resp, err := client.ListRecords(ctx, dns.ListRecordsRequest{Domain: domain})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]models.DNSRecord, 0, len(resp.Return))
+	for _, record := range resp.Return {
+		if !manageNSSOA && isApexNSOrSOA(record, domain) {
+			continue
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// syncZoneRecords is a function to add, update and delete records until the zone only holds the desired records.
+// Records that only differ in content or priority are updated in place, so they keep their ID.
+func syncZoneRecords(ctx context.Context, client *dns.Client, domain string, desired []models.DNSRecord, manageNSSOA bool) error {
+	current, err := listZoneRecords(ctx, client, domain, manageNSSOA)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]models.DNSRecord, 0)
+	for _, want := range desired {
+		if i := findRecord(current, want, sameRecord); i >= 0 {
+			current = append(current[:i], current[i+1:]...)
+			continue
+		}
+		missing = append(missing, want)
+	}
+
+	for _, want := range missing {
+		if i := findRecord(current, want, sameNameAndType); i >= 0 {
+			if err := updateRecord(ctx, client, current[i].ID, want); err != nil {
+				return err
+			}
+			current = append(current[:i], current[i+1:]...)
+			continue
+		}
+
+		if err := addRecord(ctx, client, want); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range current {
+		if err := deleteRecord(ctx, client, record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addRecord is a function to add a record to a DNS Zone.
+func addRecord(ctx context.Context, client *dns.Client, record models.DNSRecord) error {
+	resp, err := client.AddRecord(ctx, dns.AddRecordRequest{
+		Domain:   record.Domain,
+		Type:     record.Type,
+		Name:     record.Name,
+		Content:  record.Content,
+		Priority: record.Priority,
+	})
+	if err != nil {
+		return fmt.Errorf("adding %s record %q: %w", record.Type, record.Name, err)
+	}
+
+	if !resp.Status {
+		return fmt.Errorf("adding %s record %q: %s", record.Type, record.Name, resp.Msg)
+	}
+
+	log.Printf("[INFO] Added %s record %q to %s", record.Type, record.Name, record.Domain)
+
+	return nil
+}
+
+// updateRecord is a function to update a record of a DNS Zone.
+func updateRecord(ctx context.Context, client *dns.Client, id string, record models.DNSRecord) error {
+	resp, err := client.UpdateRecord(ctx, dns.UpdateRecordRequest{
+		Domain:   record.Domain,
+		RecordID: id,
+		Type:     record.Type,
+		Name:     record.Name,
+		Content:  record.Content,
+		Priority: record.Priority,
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s record %q: %w", record.Type, record.Name, err)
+	}
+
+	if !resp.Status {
+		return fmt.Errorf("updating %s record %q: %s", record.Type, record.Name, resp.Msg)
+	}
+
+	log.Printf("[INFO] Updated %s record %q (%s) in %s", record.Type, record.Name, id, record.Domain)
+
+	return nil
+}
+
+// deleteRecord is a function to delete a record from a DNS Zone.
+func deleteRecord(ctx context.Context, client *dns.Client, record models.DNSRecord) error {
+	resp, err := client.DeleteRecord(ctx, dns.DeleteRecordRequest{
+		Domain:   record.Domain,
+		RecordID: record.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s record %q: %w", record.Type, record.Name, err)
+	}
+
+	if !resp.Status {
+		return fmt.Errorf("deleting %s record %q: %s", record.Type, record.Name, resp.Msg)
+	}
+
+	log.Printf("[INFO] Deleted %s record %q (%s) from %s", record.Type, record.Name, record.ID, record.Domain)
+
+	return nil
+}
+
+// findRecord is a function to find the index of the first record matching the wanted record, or -1.
+func findRecord(records []models.DNSRecord, want models.DNSRecord, match func(a, b models.DNSRecord) bool) int {
+	for i, record := range records {
+		if match(record, want) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// recordName is a function to get the fully qualified name of a record, the way the SiteHost API stores it.
+// The name can be given relative to the domain, or as `@` for the domain itself.
+func recordName(name, domain string) string {
+	name = strings.TrimSuffix(name, ".")
+	domain = strings.TrimSuffix(domain, ".")
+
+	switch lower := strings.ToLower(name); {
+	case lower == "@":
+		return domain
+	case lower == strings.ToLower(domain), strings.HasSuffix(lower, "."+strings.ToLower(domain)):
+		return name
+	default:
+		return name + "." + domain
+	}
+}
+
+// sameNameAndType is a function to check if two records have the same name, ignoring case, and type.
+func sameNameAndType(a, b models.DNSRecord) bool {
+	return strings.EqualFold(a.Name, b.Name) && a.Type == b.Type
+}
+
+// sameRecord is a function to check if two records are the same, ignoring their ID.
+func sameRecord(a, b models.DNSRecord) bool {
+	return sameNameAndType(a, b) && a.Content == b.Content && recordPriority(a) == recordPriority(b)
+}
+
+// isApexNSOrSOA is a function to check if a record is the SOA record or a NS record of the zone apex.
+// NS records below the apex delegate a subdomain, so they are managed like any other record.
+func isApexNSOrSOA(record models.DNSRecord, domain string) bool {
+	if record.Type == "SOA" {
+		return true
+	}
+
+	return record.Type == "NS" && strings.EqualFold(strings.TrimSuffix(record.Name, "."), strings.TrimSuffix(domain, "."))
+}
+
+// recordPriority is a function to get the priority of a record, the API returns an empty priority for some types.
+func recordPriority(record models.DNSRecord) int {
+	priority, err := strconv.Atoi(record.Priority)
+	if err != nil {
+		return 0
+	}
+
+	return priority
+}
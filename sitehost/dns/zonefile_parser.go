@@ -0,0 +1,551 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sitehostnz/gosh/pkg/models"
+)
+
+// txtChunkLength is the longest character string allowed in a TXT record, longer values are split.
+const txtChunkLength = 255
+
+// ttlPattern matches a TTL in seconds or in the BIND unit format, such as 3600 or 1h30m.
+var ttlPattern = regexp.MustCompile(`^[0-9]+([smhdwSMHDW][0-9]*)*$`)
+
+// zoneToken is a word or a quoted character string of a zone file.
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneLine is a logical line of a zone file, parentheses can spread it over several physical lines.
+type zoneLine struct {
+	number int
+	tokens []zoneToken
+	// inherit is set when the line starts with a blank, so the owner of the previous record is used.
+	inherit bool
+}
+
+// parseZoneFile is a function to parse RFC 1035 zone file content into records of the given domain.
+// Record names and targets are returned fully qualified without the trailing dot, the way the SiteHost API stores them.
+// TTLs and classes are accepted but not kept, and $INCLUDE and $GENERATE are not supported.
+func parseZoneFile(content, domain string) ([]models.DNSRecord, error) {
+	lines, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	origin := domain
+	owner := ""
+
+	records := make([]models.DNSRecord, 0, len(lines))
+	for _, line := range lines {
+		if first := line.tokens[0]; !line.inherit && !first.quoted && strings.HasPrefix(first.text, "$") {
+			if origin, err = parseDirective(line, origin); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		tokens := line.tokens
+		if !line.inherit {
+			owner = expandName(tokens[0].text, origin)
+			tokens = tokens[1:]
+		}
+
+		if err := checkOwner(owner, domain); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		record, err := parseRecord(tokens, owner, origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		record.Domain = domain
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// checkOwner is a function to check that a record has an owner name within the zone.
+func checkOwner(owner, domain string) error {
+	if owner == "" {
+		return errors.New("record without an owner name")
+	}
+
+	if owner != domain && !strings.HasSuffix(owner, "."+domain) {
+		return fmt.Errorf("%q is outside of the zone %q", owner, domain)
+	}
+
+	return nil
+}
+
+// parseDirective is a function to handle a $ directive of a zone file, it returns the new origin.
+func parseDirective(line zoneLine, origin string) (string, error) {
+	directive := strings.ToUpper(line.tokens[0].text)
+
+	switch directive {
+	case "$ORIGIN":
+		if len(line.tokens) != 2 {
+			return "", fmt.Errorf("line %d: $ORIGIN expects one domain name", line.number)
+		}
+		return expandName(line.tokens[1].text, origin), nil
+	case "$TTL":
+		// SiteHost sets the TTL of the records, so the default TTL is not used.
+		return origin, nil
+	default:
+		return "", fmt.Errorf("line %d: %s is not supported", line.number, directive)
+	}
+}
+
+// parseRecord is a function to parse the TTL, class, type and data of a record.
+func parseRecord(tokens []zoneToken, owner, origin string) (models.DNSRecord, error) {
+	// The TTL and the class are both optional and can come in either order.
+	for i := 0; i < 2 && len(tokens) > 0; i++ {
+		text := strings.ToUpper(tokens[0].text)
+		if tokens[0].quoted || (!ttlPattern.MatchString(text) && text != "IN") {
+			break
+		}
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) == 0 {
+		return models.DNSRecord{}, fmt.Errorf("record %q has no type", owner)
+	}
+
+	record := models.DNSRecord{
+		Name:     owner,
+		Type:     strings.ToUpper(tokens[0].text),
+		Priority: "0",
+	}
+
+	if err := parseRecordData(&record, tokens[1:], origin); err != nil {
+		return record, fmt.Errorf("%s record %q: %w", record.Type, owner, err)
+	}
+
+	return record, nil
+}
+
+// recordDataParsers are the functions to set the content and priority of a record from its data, by record type.
+var recordDataParsers = map[string]func(record *models.DNSRecord, data []zoneToken, origin string) error{
+	"A":     parseAddressData,
+	"AAAA":  parseAddressData,
+	"CNAME": parseNameData,
+	"NS":    parseNameData,
+	"MX":    parseMXData,
+	"SRV":   parseSRVData,
+	"TXT":   parseTXTData,
+	"CAA":   parseCAAData,
+	"SOA":   parseSOAData,
+}
+
+// parseRecordData is a function to set the content and priority of a record from its data.
+func parseRecordData(record *models.DNSRecord, data []zoneToken, origin string) error {
+	parse, ok := recordDataParsers[record.Type]
+	if !ok {
+		return fmt.Errorf("record type is not supported")
+	}
+
+	return parse(record, data, origin)
+}
+
+// parseAddressData is a function to parse the address of an A or AAAA record.
+func parseAddressData(record *models.DNSRecord, data []zoneToken, _ string) error {
+	if len(data) != 1 {
+		return fmt.Errorf("expects one address")
+	}
+
+	ip := net.ParseIP(data[0].text)
+	if ip == nil || (ip.To4() != nil) != (record.Type == "A") {
+		return fmt.Errorf("invalid address %q", data[0].text)
+	}
+
+	record.Content = data[0].text
+
+	return nil
+}
+
+// parseNameData is a function to parse the domain name of a CNAME or NS record.
+func parseNameData(record *models.DNSRecord, data []zoneToken, origin string) error {
+	if len(data) != 1 {
+		return fmt.Errorf("expects one domain name")
+	}
+
+	record.Content = expandName(data[0].text, origin)
+
+	return nil
+}
+
+// parseMXData is a function to parse the preference and mail exchanger of a MX record.
+func parseMXData(record *models.DNSRecord, data []zoneToken, origin string) error {
+	if len(data) != 2 {
+		return fmt.Errorf("expects a preference and a mail exchanger")
+	}
+
+	if _, err := strconv.ParseUint(data[0].text, 10, 16); err != nil {
+		return fmt.Errorf("invalid preference %q", data[0].text)
+	}
+
+	record.Priority = data[0].text
+	record.Content = expandName(data[1].text, origin)
+
+	return nil
+}
+
+// parseSRVData is a function to parse the priority, weight, port and target of a SRV record.
+// The priority is kept apart, the way the SiteHost API stores it.
+func parseSRVData(record *models.DNSRecord, data []zoneToken, origin string) error {
+	if len(data) != 4 {
+		return fmt.Errorf("expects a priority, weight, port and target")
+	}
+
+	for _, t := range data[:3] {
+		if _, err := strconv.ParseUint(t.text, 10, 16); err != nil {
+			return fmt.Errorf("invalid number %q", t.text)
+		}
+	}
+
+	record.Priority = data[0].text
+	record.Content = fmt.Sprintf("%s %s %s", data[1].text, data[2].text, expandName(data[3].text, origin))
+
+	return nil
+}
+
+// parseTXTData is a function to join the character strings of a TXT record.
+func parseTXTData(record *models.DNSRecord, data []zoneToken, _ string) error {
+	if len(data) == 0 {
+		return fmt.Errorf("expects at least one character string")
+	}
+
+	var b strings.Builder
+	for _, t := range data {
+		b.WriteString(t.text)
+	}
+
+	record.Content = b.String()
+
+	return nil
+}
+
+// parseCAAData is a function to parse the flag, tag and value of a CAA record.
+func parseCAAData(record *models.DNSRecord, data []zoneToken, _ string) error {
+	if len(data) != 3 {
+		return fmt.Errorf("expects a flag, tag and value")
+	}
+
+	record.Content = fmt.Sprintf("%s %s %s", data[0].text, data[1].text, quoteString(data[2].text))
+
+	return nil
+}
+
+// parseSOAData is a function to keep the data of a SOA record as it is, SiteHost manages the SOA record.
+func parseSOAData(record *models.DNSRecord, data []zoneToken, _ string) error {
+	texts := make([]string, len(data))
+	for i, t := range data {
+		texts[i] = t.text
+	}
+
+	record.Content = strings.Join(texts, " ")
+
+	return nil
+}
+
+// splitZoneFile is a function to split zone file content into logical lines of tokens, dropping comments.
+func splitZoneFile(content string) ([]zoneLine, error) {
+	s := &zoneScanner{number: 1}
+
+	for i := 0; i < len(content); i++ {
+		if err := s.scan(content, &i); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.quoted {
+		return nil, fmt.Errorf("line %d: unterminated character string", s.number)
+	}
+
+	if s.depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", s.number)
+	}
+
+	s.endLine()
+
+	return s.lines, nil
+}
+
+// zoneScanner holds the state of splitZoneFile while it walks through the zone file.
+type zoneScanner struct {
+	lines   []zoneLine
+	current zoneLine
+	word    strings.Builder
+	inWord  bool
+	quoted  bool
+	comment bool
+	depth   int
+	number  int
+}
+
+// scan is a function to handle the character at position i, escapes move i past the escaped characters.
+func (s *zoneScanner) scan(content string, i *int) error {
+	c := content[*i]
+
+	var err error
+	switch {
+	case c == '\n':
+		return s.newline()
+	case s.comment:
+		return nil
+	case c == '\\':
+		s.startWord()
+		var n int
+		n, err = s.escape(content[*i+1:])
+		*i += n
+	case s.quoted:
+		s.scanQuoted(c)
+	default:
+		err = s.scanUnquoted(c)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if s.current.number == 0 && len(s.current.tokens) > 0 {
+		s.current.number = s.number
+	}
+
+	return nil
+}
+
+// newline is a function to end the line being read, unless it continues within parentheses.
+func (s *zoneScanner) newline() error {
+	if s.quoted {
+		return fmt.Errorf("line %d: unterminated character string", s.number)
+	}
+
+	s.endWord()
+	s.comment = false
+	if s.depth == 0 {
+		s.endLine()
+	}
+	s.number++
+
+	return nil
+}
+
+// scanQuoted is a function to handle a character within a character string.
+func (s *zoneScanner) scanQuoted(c byte) {
+	if c == '"' {
+		s.endWord()
+		s.quoted = false
+		return
+	}
+
+	s.word.WriteByte(c)
+}
+
+// scanUnquoted is a function to handle a character outside of a comment or a character string.
+func (s *zoneScanner) scanUnquoted(c byte) error {
+	switch c {
+	case '"':
+		s.endWord()
+		s.quoted = true
+		s.current.tokens = append(s.current.tokens, zoneToken{quoted: true})
+	case ';':
+		s.endWord()
+		s.comment = true
+	case '(':
+		s.endWord()
+		s.depth++
+	case ')':
+		s.endWord()
+		if s.depth == 0 {
+			return fmt.Errorf("line %d: unbalanced parentheses", s.number)
+		}
+		s.depth--
+	case ' ', '\t', '\r':
+		if len(s.current.tokens) == 0 {
+			s.current.inherit = true
+		}
+		s.endWord()
+	default:
+		s.startWord()
+		s.word.WriteByte(c)
+	}
+
+	return nil
+}
+
+// escape is a function to write an escaped character, it returns how many characters were consumed.
+// A backslash at the end of a line is an error, lines cannot be continued this way.
+func (s *zoneScanner) escape(rest string) (int, error) {
+	if rest == "" || rest[0] == '\n' || strings.HasPrefix(rest, "\r\n") {
+		return 0, fmt.Errorf("line %d: backslash at end of line", s.number)
+	}
+
+	if len(rest) >= 3 && isDigits(rest[:3]) {
+		if n, err := strconv.Atoi(rest[:3]); err == nil && n < 256 {
+			s.word.WriteByte(byte(n))
+			return 3, nil
+		}
+	}
+
+	s.word.WriteByte(rest[0])
+
+	return 1, nil
+}
+
+// startWord is a function to start a new unquoted word, unless a word or a character string is already open.
+func (s *zoneScanner) startWord() {
+	if s.inWord || s.quoted {
+		return
+	}
+
+	s.inWord = true
+	s.current.tokens = append(s.current.tokens, zoneToken{})
+}
+
+// endWord is a function to store the word being read in the last token.
+func (s *zoneScanner) endWord() {
+	if n := len(s.current.tokens); n > 0 && (s.inWord || s.current.tokens[n-1].quoted) && s.word.Len() > 0 {
+		s.current.tokens[n-1].text += s.word.String()
+	}
+
+	s.word.Reset()
+	s.inWord = false
+}
+
+// endLine is a function to store the logical line being read, empty lines are skipped.
+func (s *zoneScanner) endLine() {
+	s.endWord()
+
+	if len(s.current.tokens) > 0 {
+		s.lines = append(s.lines, s.current)
+	}
+
+	s.current = zoneLine{}
+}
+
+// renderZoneFile is a function to render the records of a domain as RFC 1035 zone file content.
+// The records are sorted, so the output only changes when the records do.
+func renderZoneFile(domain string, records []models.DNSRecord) string {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	sorted := make([]models.DNSRecord, len(records))
+	copy(sorted, records)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if recordPriority(a) != recordPriority(b) {
+			return recordPriority(a) < recordPriority(b)
+		}
+		return a.Content < b.Content
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", domain)
+
+	for _, record := range sorted {
+		owner := relativeName(record.Name, domain)
+		if ttl, err := strconv.Atoi(record.TTL); err == nil {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", owner, ttl, record.Type, renderRecordData(record))
+		} else {
+			fmt.Fprintf(&b, "%s\tIN\t%s\t%s\n", owner, record.Type, renderRecordData(record))
+		}
+	}
+
+	return b.String()
+}
+
+// renderRecordData is a function to render the data of a record in zone file format.
+func renderRecordData(record models.DNSRecord) string {
+	switch record.Type {
+	case "CNAME", "NS":
+		return absoluteName(record.Content)
+	case "MX":
+		return fmt.Sprintf("%d %s", recordPriority(record), absoluteName(record.Content))
+	case "SRV":
+		fields := strings.Fields(record.Content)
+		if n := len(fields); n > 0 {
+			fields[n-1] = absoluteName(fields[n-1])
+		}
+		return fmt.Sprintf("%d %s", recordPriority(record), strings.Join(fields, " "))
+	case "TXT":
+		chunks := make([]string, 0, len(record.Content)/txtChunkLength+1)
+		for s := record.Content; ; s = s[txtChunkLength:] {
+			if len(s) <= txtChunkLength {
+				chunks = append(chunks, quoteString(s))
+				break
+			}
+			chunks = append(chunks, quoteString(s[:txtChunkLength]))
+		}
+		return strings.Join(chunks, " ")
+	default:
+		return record.Content
+	}
+}
+
+// expandName is a function to make a zone file name fully qualified, without the trailing dot.
+func expandName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	default:
+		return strings.ToLower(name + "." + origin)
+	}
+}
+
+// relativeName is a function to write a fully qualified name relative to the domain where possible.
+func relativeName(name, domain string) string {
+	name = strings.ToLower(name)
+
+	switch {
+	case name == domain:
+		return "@"
+	case strings.HasSuffix(name, "."+domain):
+		return strings.TrimSuffix(name, "."+domain)
+	default:
+		return absoluteName(name)
+	}
+}
+
+// absoluteName is a function to add the trailing dot to a fully qualified name.
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// quoteString is a function to quote a zone file character string, escaping quotes and backslashes.
+func quoteString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// isDigits is a function to check that a string only holds decimal digits.
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
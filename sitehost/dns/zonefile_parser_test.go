@@ -0,0 +1,174 @@
+package dns
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sitehostnz/gosh/pkg/models"
+)
+
+// exampleRecord is a function to build an expected record of the example.com zone.
+func exampleRecord(name, recordType, content, priority string) models.DNSRecord {
+	return models.DNSRecord{
+		Name:     name,
+		Domain:   "example.com",
+		Type:     recordType,
+		Content:  content,
+		Priority: priority,
+	}
+}
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    []models.DNSRecord
+		err     string
+	}{
+		{
+			name:    "origin and at",
+			content: "$ORIGIN example.com.\n@ IN A 192.0.2.1\nwww IN 300 CNAME @\n",
+			want: []models.DNSRecord{
+				exampleRecord("example.com", "A", "192.0.2.1", "0"),
+				exampleRecord("www.example.com", "CNAME", "example.com", "0"),
+			},
+		},
+		{
+			name:    "nested origin",
+			content: "$ORIGIN dev.example.com.\napi A 192.0.2.2\n",
+			want: []models.DNSRecord{
+				exampleRecord("api.dev.example.com", "A", "192.0.2.2", "0"),
+			},
+		},
+		{
+			name:    "inherited owner",
+			content: "www 3600 IN A 192.0.2.1\n    IN AAAA 2001:db8::1\n",
+			want: []models.DNSRecord{
+				exampleRecord("www.example.com", "A", "192.0.2.1", "0"),
+				exampleRecord("www.example.com", "AAAA", "2001:db8::1", "0"),
+			},
+		},
+		{
+			name:    "multi-line parentheses",
+			content: "@ IN TXT ( \"v=spf1\" ; the policy\n  \" -all\" )\nwww IN A 192.0.2.1\n",
+			want: []models.DNSRecord{
+				exampleRecord("example.com", "TXT", "v=spf1 -all", "0"),
+				exampleRecord("www.example.com", "A", "192.0.2.1", "0"),
+			},
+		},
+		{
+			name:    "comment inside quotes",
+			content: "@ IN TXT \"a;b\" ; a comment\n",
+			want: []models.DNSRecord{
+				exampleRecord("example.com", "TXT", "a;b", "0"),
+			},
+		},
+		{
+			name:    "escapes",
+			content: "@ IN TXT \"say \\\"hi\\\" \\065\"\n",
+			want: []models.DNSRecord{
+				exampleRecord("example.com", "TXT", "say \"hi\" A", "0"),
+			},
+		},
+		{
+			name:    "mx priority",
+			content: "@ IN MX 10 mail\n",
+			want: []models.DNSRecord{
+				exampleRecord("example.com", "MX", "mail.example.com", "10"),
+			},
+		},
+		{
+			name:    "srv priority",
+			content: "_sip._tcp IN SRV 10 60 5060 sip.example.com.\n",
+			want: []models.DNSRecord{
+				exampleRecord("_sip._tcp.example.com", "SRV", "60 5060 sip.example.com", "10"),
+			},
+		},
+		{
+			name:    "invalid address",
+			content: "www IN A 1.2.3\n",
+			err:     "line 1: A record \"www.example.com\": invalid address \"1.2.3\"",
+		},
+		{
+			name:    "outside of the zone",
+			content: "\nx.other.org. IN A 192.0.2.1\n",
+			err:     "line 2: \"x.other.org\" is outside of the zone \"example.com\"",
+		},
+		{
+			name:    "unterminated character string",
+			content: "@ IN TXT \"abc\n",
+			err:     "line 1: unterminated character string",
+		},
+		{
+			name:    "unbalanced parentheses",
+			content: "@ IN TXT \"abc\" )\n",
+			err:     "line 1: unbalanced parentheses",
+		},
+		{
+			name:    "include",
+			content: "$INCLUDE other.zone\n",
+			err:     "line 1: $INCLUDE is not supported",
+		},
+		{
+			name:    "no owner",
+			content: "  IN A 192.0.2.1\n",
+			err:     "line 1: record without an owner name",
+		},
+		{
+			name:    "backslash at end of line",
+			content: "www IN A 192.0.2.1\n@ IN TXT abc\\\ndef\n",
+			err:     "line 2: backslash at end of line",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile(tt.content, "example.com")
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("parseZoneFile() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseZoneFile() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseZoneFile() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFileRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	records := []models.DNSRecord{
+		exampleRecord("example.com", "MX", "mail.example.com", "10"),
+		exampleRecord("example.com", "TXT", strings.Repeat("a", 300)+"\"b\\", "0"),
+		exampleRecord("_sip._tcp.example.com", "SRV", "60 5060 sip.example.com", "10"),
+		exampleRecord("www.example.com", "CNAME", "example.com", "0"),
+	}
+
+	content := renderZoneFile("example.com", records)
+
+	if !strings.Contains(content, "\""+strings.Repeat("a", txtChunkLength)+"\" \"") {
+		t.Errorf("renderZoneFile() did not split the TXT record into character strings:\n%s", content)
+	}
+
+	got, err := parseZoneFile(content, "example.com")
+	if err != nil {
+		t.Fatalf("parseZoneFile() error = %v\n%s", err, content)
+	}
+
+	if !sameRecordSet(got, records) {
+		t.Errorf("parseZoneFile(renderZoneFile()) = %+v, want %+v", got, records)
+	}
+}
@@ -23,7 +23,7 @@ const (
 	JobStatusFailed = "Failed"
 	// JobRequestDelay is the time wait to send a new request to check the job status.
 	JobRequestDelay = 10 * time.Second
-	// JobRequestTimeout is the time to wait before timeout.
+	// JobRequestTimeout is the default time to wait before timeout, resources can override it with `timeouts {}`.
 	JobRequestTimeout = 60 * time.Minute
 	// JobRequestMinTimeout is the minimum time to wait before refreshes.
 	JobRequestMinTimeout = 3 * time.Second
@@ -68,11 +68,11 @@ func (c *Config) Client() (*CombinedConfig, diag.Diagnostics) {
 }
 
 // WaitForAction is a function to check the Job status in a refresh function.
-func WaitForAction(client *api.Client, jobID string, jobType string) error {
+// It stops polling when the timeout is reached or ctx is cancelled, and returns a *JobError when the job fails.
+func WaitForAction(ctx context.Context, client *api.Client, jobID string, jobType string, timeout time.Duration) error {
 	var (
 		pending   = JobStatusPending
 		target    = JobStatusCompleted
-		ctx       = context.Background()
 		refreshFn = func() (result any, state string, err error) {
 			svc := job.New(client)
 
@@ -90,7 +90,7 @@ func WaitForAction(client *api.Client, jobID string, jobType string) error {
 
 			switch j.Return.State {
 			case JobStatusFailed:
-				return j, JobStatusFailed, nil
+				return j, JobStatusFailed, newJobError(jobID, jobType, j.Return)
 			case target:
 				return j, target, nil
 			default:
@@ -104,7 +104,7 @@ func WaitForAction(client *api.Client, jobID string, jobType string) error {
 		Refresh:        refreshFn,
 		Target:         []string{target},
 		Delay:          JobRequestDelay,
-		Timeout:        JobRequestTimeout,
+		Timeout:        timeout,
 		MinTimeout:     JobRequestMinTimeout,
 		NotFoundChecks: JobRequestNotFoundChecks,
 	}).WaitForStateContext(ctx)
@@ -0,0 +1,38 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/sitehostnz/gosh/pkg/models"
+)
+
+// notFoundMessages are the fragments of an API message which mean the requested object does not exist.
+var notFoundMessages = []string{
+	"not found",
+	"does not exist",
+	"no such",
+}
+
+// IsNotFound reports whether err is a SiteHost API response saying the requested object does not exist.
+// The HTTP status alone is not enough, a proxy error page or a wrong api_endpoint also returns 404 without an API body.
+func IsNotFound(err error) bool {
+	var errResp *models.ErrorResponse
+	if !errors.As(err, &errResp) {
+		return false
+	}
+
+	return !errResp.Status && IsNotFoundMessage(errResp.Message)
+}
+
+// IsNotFoundMessage reports whether msg is a SiteHost API message saying the requested object does not exist.
+func IsNotFoundMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, m := range notFoundMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/sitehostnz/gosh/pkg/models"
+)
+
+// JobError is returned by WaitForAction when a job finishes in a failed state.
+type JobError struct {
+	ID      string
+	Type    string
+	State   string
+	Message string
+	Logs    []models.Log
+}
+
+// newJobError returns a JobError with the details of a finished job.
+func newJobError(jobID string, jobType string, details models.JobDetails) *JobError {
+	return &JobError{
+		ID:      jobID,
+		Type:    jobType,
+		State:   details.State,
+		Message: details.Message,
+		Logs:    details.Logs,
+	}
+}
+
+// Error returns a JobError message.
+func (e *JobError) Error() string {
+	return fmt.Sprintf("job %s (%s) finished with state %q: %s", e.ID, e.Type, e.State, e.reason())
+}
+
+// Detail returns the job details and logs to show in a diagnostic.
+func (e *JobError) Detail() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Job ID: %s\nJob type: %s\nState: %s\nMessage: %s", e.ID, e.Type, e.State, e.reason())
+
+	if len(e.Logs) > 0 {
+		b.WriteString("\nLogs:")
+		for _, l := range e.Logs {
+			fmt.Fprintf(&b, "\n  %s [%s] %s", l.Date, l.Level, l.Message)
+		}
+	}
+
+	return b.String()
+}
+
+// reason returns the failure message of the job, falling back to the last log entry.
+func (e *JobError) reason() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	if len(e.Logs) > 0 {
+		return e.Logs[len(e.Logs)-1].Message
+	}
+
+	return "no message returned by the API"
+}
+
+// JobDiagnostics turns an error from WaitForAction into diagnostics, adding the job details when the job failed.
+func JobDiagnostics(summary string, err error) diag.Diagnostics {
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) {
+		return diag.Errorf("%s: %s", summary, err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s: %s", summary, jobErr.reason()),
+			Detail:   jobErr.Detail(),
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/cloud/database"
 	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/cloud/stack"
 	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/dns"
 	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
@@ -40,19 +41,30 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"sitehost_server":  server.DataSource(),
-				"sitehost_api":     info.DataSource(),
-				"sitehost_stack":   stack.DataSource(),
-				"sitehost_ssh_key": sshkey.DataSource(),
-				// "sitehost_stack_database": database.DataSource(),
+				"sitehost_server":         server.DataSource(),
+				"sitehost_servers":        server.ServersDataSource(),
+				"sitehost_api":            info.DataSource(),
+				"sitehost_stack":          stack.DataSource(),
+				"sitehost_ssh_key":        sshkey.DataSource(),
+				"sitehost_stack_database": database.DataSource(),
+				"sitehost_dns_zone_file":  dns.ZoneFileDataSource(),
+				"sitehost_dns_zone":       dns.ZoneDataSource(),
+				"sitehost_dns_records":    dns.RecordsDataSource(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"sitehost_server":                server.Resource(),
-				"sitehost_dns_zone":              dns.ZoneResource(),
-				"sitehost_dns_record":            dns.RecordResource(),
-				"sitehost_ssh_key":               sshkey.Resource(),
-				"sitehost_server_security_group": securitygroups.Resource(),
-				"sitehost_server_firewall":       firewall.Resource(),
+				"sitehost_server":                  server.Resource(),
+				"sitehost_dns_zone":                dns.ZoneResource(),
+				"sitehost_dns_record":              dns.RecordResource(),
+				"sitehost_dns_zone_records":        dns.ZoneRecordsResource(),
+				"sitehost_dns_zone_file":           dns.ZoneFileResource(),
+				"sitehost_ssh_key":                 sshkey.Resource(),
+				"sitehost_server_security_group":   securitygroups.Resource(),
+				"sitehost_server_firewall":         firewall.Resource(),
+				"sitehost_cloud_stack":             stack.Resource(),
+				"sitehost_cloud_stack_environment": stack.EnvironmentResource(),
+				"sitehost_cloud_database":          database.Resource(),
+				"sitehost_cloud_database_user":     database.UserResource(),
+				"sitehost_cloud_database_grant":    database.GrantResource(),
 			},
 		}
 
@@ -0,0 +1,8 @@
+package server
+
+const (
+	// ipv4 is the address family of an IPv4 address.
+	ipv4 = 4
+	// ipv6 is the address family of an IPv6 address.
+	ipv6 = 6
+)
@@ -3,9 +3,16 @@ package server
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sitehostnz/gosh/pkg/api/server"
+	"github.com/sitehostnz/gosh/pkg/api/server/firewall"
+	"github.com/sitehostnz/gosh/pkg/models"
+	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
 )
 
 // DataSource returns a schema with the function to read Server resource.
@@ -18,8 +25,191 @@ func DataSource() *schema.Resource {
 	}
 }
 
-// readDataSource is a function to read a servers (not implemented).
-func readDataSource(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
-	fmt.Println("not implemented")
+// ServersDataSource returns a schema with the function to read a filtered list of Server resources.
+func ServersDataSource() *schema.Resource {
+	recordSchema := serversDataSourceSchema()
+
+	return &schema.Resource{
+		ReadContext: readServersDataSource,
+		Schema:      recordSchema,
+	}
+}
+
+// readDataSource is a function to read a server by name or label.
+func readDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	client := server.New(conf.Client)
+
+	name := fmt.Sprint(d.Get("name"))
+	if name == "" {
+		var diags diag.Diagnostics
+		if name, diags = findServerByLabel(ctx, client, fmt.Sprint(d.Get("label"))); diags != nil {
+			return diags
+		}
+	}
+
+	resp, err := client.Get(ctx, server.GetRequest{
+		ServerName: name,
+	})
+	if err != nil {
+		return diag.Errorf("Error retrieving server: %s", err)
+	}
+
+	if !resp.Status {
+		return diag.Errorf("Error retrieving server: %s", resp.Msg)
+	}
+
+	groups, diags := getSecurityGroups(ctx, conf, resp.Server.Name)
+	if diags != nil {
+		return diags
+	}
+
+	d.SetId(resp.Server.Name)
+
+	if err := setDataSourceAttributes(d, resp.Server, groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readServersDataSource is a function to read all the servers matching the filters.
+func readServersDataSource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	conf, ok := meta.(*helper.CombinedConfig)
+	if !ok {
+		return diag.Errorf("failed to convert meta object")
+	}
+
+	var labelRegex *regexp.Regexp
+	if v := fmt.Sprint(d.Get("label_regex")); v != "" {
+		var err error
+		if labelRegex, err = regexp.Compile(v); err != nil {
+			return diag.Errorf("Error compiling label_regex: %s", err)
+		}
+	}
+
+	location := fmt.Sprint(d.Get("location"))
+	productCode := fmt.Sprint(d.Get("product_code"))
+
+	list, diags := listServers(ctx, server.New(conf.Client))
+	if diags != nil {
+		return diags
+	}
+
+	names := make([]string, 0)
+	servers := make([]map[string]any, 0)
+	for _, s := range list {
+		if !matchServer(s, labelRegex, location, productCode) {
+			continue
+		}
+
+		names = append(names, s.Name)
+		servers = append(servers, serverAttributes(s))
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+
+	if err := d.Set("servers", servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// matchServer is a function to check if a server matches the filters of the servers data source, empty filters match any server.
+func matchServer(s models.Server, labelRegex *regexp.Regexp, location, productCode string) bool {
+	if labelRegex != nil && !labelRegex.MatchString(s.Label) {
+		return false
+	}
+
+	if location != "" && s.LocationCode != location {
+		return false
+	}
+
+	return productCode == "" || s.ProductCode == productCode
+}
+
+// listServers is a function to list all the servers of the account.
+// The API only returns the first page and cannot be asked for the others, so more pages are an error
+// instead of silently missing servers.
+func listServers(ctx context.Context, client *server.Client) ([]models.Server, diag.Diagnostics) {
+	resp, err := client.List(ctx)
+	if err != nil {
+		return nil, diag.Errorf("Error listing servers: %s", err)
+	}
+
+	if !resp.Status {
+		return nil, diag.Errorf("Error listing servers: %s", resp.Msg)
+	}
+
+	if resp.Return.TotalPages > 1 {
+		return nil, diag.Errorf("Error listing servers: the API returned page %d of %d and the other pages cannot be read",
+			resp.Return.CurrentPage, resp.Return.TotalPages)
+	}
+
+	return resp.Return.Servers, nil
+}
+
+// findServerByLabel is a function to find the name of the only server with the given label.
+func findServerByLabel(ctx context.Context, client *server.Client, label string) (string, diag.Diagnostics) {
+	list, diags := listServers(ctx, client)
+	if diags != nil {
+		return "", diags
+	}
+
+	names := make([]string, 0)
+	for _, s := range list {
+		if s.Label == label {
+			names = append(names, s.Name)
+		}
+	}
+
+	switch len(names) {
+	case 0:
+		return "", diag.Errorf("Error finding server: no server with label %q", label)
+	case 1:
+		return names[0], nil
+	default:
+		return "", diag.Errorf("Error finding server: %d servers with label %q, use the name instead", len(names), label)
+	}
+}
+
+// getSecurityGroups is a function to get the security groups applied to the firewall of a server.
+func getSecurityGroups(ctx context.Context, conf *helper.CombinedConfig, serverName string) ([]string, diag.Diagnostics) {
+	resp, err := firewall.New(conf.Client).Get(ctx, firewall.GetRequest{
+		ServerName: serverName,
+	})
+	if err != nil {
+		return nil, diag.Errorf("Error retrieving server firewall: %s", err)
+	}
+
+	if !resp.Status {
+		return nil, diag.Errorf("Error retrieving server firewall: %s", resp.Message)
+	}
+
+	groups := make([]string, len(resp.Return))
+	for i, group := range resp.Return {
+		groups[i] = group.Group
+	}
+
+	return groups, nil
+}
+
+// setDataSourceAttributes is a function to set the data source attributes of a server.
+func setDataSourceAttributes(d *schema.ResourceData, s models.Server, groups []string) error {
+	attributes := serverAttributes(s)
+	attributes["image"] = s.Distro
+	attributes["securitygroups"] = groups
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
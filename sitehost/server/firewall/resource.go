@@ -4,6 +4,7 @@ package firewall
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,11 @@ func Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
 		Schema: resourceSchema,
 	}
 }
@@ -38,6 +44,11 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		ServerName: d.Get("server").(string),
 	})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Server (%s) not found, removing firewall from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error reading server: %s", err)
 	}
 
@@ -79,8 +90,13 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diags
 	}
 
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type); err != nil {
-		return diag.FromErr(err)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, timeout); err != nil {
+		return helper.JobDiagnostics("Error updating server firewall", err)
 	}
 
 	d.SetId(serverName)
@@ -106,8 +122,8 @@ func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diags
 	}
 
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type); err != nil {
-		return diag.FromErr(err)
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return helper.JobDiagnostics("Error clearing server firewall", err)
 	}
 
 	// Clear the ID when the resource is deleted
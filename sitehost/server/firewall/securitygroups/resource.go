@@ -22,6 +22,11 @@ func Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
 		Schema: resourceSchema,
 	}
 }
@@ -91,8 +96,13 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.Errorf("Error updating security group: %s", res.Msg)
 	}
 
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type); err != nil {
-		return diag.FromErr(err)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, timeout); err != nil {
+		return helper.JobDiagnostics("Error updating security group", err)
 	}
 
 	return readResource(ctx, d, meta)
@@ -170,10 +180,20 @@ func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		Name: d.Id(),
 	})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Security Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error reading security group: %s", err)
 	}
 
 	if !resp.Status {
+		if helper.IsNotFoundMessage(resp.Msg) {
+			log.Printf("[WARN] Security Group (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error reading security group: %s", resp.Msg)
 	}
 
@@ -5,10 +5,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sitehostnz/gosh/pkg/api/server"
+	"github.com/sitehostnz/gosh/pkg/api/server/firewall"
+	sshkey "github.com/sitehostnz/gosh/pkg/api/ssh/key"
 	"github.com/sitehostnz/gosh/pkg/models"
 	"github.com/sitehostnz/terraform-provider-sitehost/sitehost/helper"
 )
@@ -21,9 +24,15 @@ func Resource() *schema.Resource {
 		UpdateContext: updateResource,
 		DeleteContext: deleteResource,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importResource,
 		},
-		Schema: resourceSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Update: schema.DefaultTimeout(helper.JobRequestTimeout),
+			Delete: schema.DefaultTimeout(helper.JobRequestTimeout),
+		},
+		CustomizeDiff: clearSecurityGroups,
+		Schema:        resourceSchema,
 	}
 }
 
@@ -36,14 +45,9 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	client := server.New(conf.Client)
 
-	keys, ok := d.Get("ssh_keys").([]any)
-	if !ok {
-		return diag.Errorf("failed to convert ssh keys object")
-	}
-
-	sshKeys := make([]string, 0, len(keys))
-	for _, key := range keys {
-		sshKeys = append(sshKeys, fmt.Sprint(key))
+	sshKeys, diags := getSSHKeys(ctx, conf, d)
+	if diags != nil {
+		return diags
 	}
 
 	opts := server.CreateRequest{
@@ -67,30 +71,36 @@ func createResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	// Set data
 	d.SetId(res.Return.Name)
-	if err := d.Set("name", res.Return.Name); err != nil {
-		return diag.FromErr(err)
+	attributes := map[string]any{
+		"name":     res.Return.Name,
+		"password": res.Return.Password,
+		"ips":      res.Return.Ips,
 	}
 
-	if err := d.Set("password", res.Return.Password); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set("ips", res.Return.Ips); err != nil {
-		return diag.FromErr(err)
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	// wait for "Completed" status
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type); err != nil {
-		return diag.FromErr(err)
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return helper.JobDiagnostics("Error creating server", err)
 	}
 
 	log.Printf("[INFO] Server Name: %s", d.Id())
 
-	return nil
+	if _, ok := d.GetOk("securitygroups"); ok {
+		if diags := updateSecurityGroups(ctx, conf, d, d.Timeout(schema.TimeoutCreate)); diags != nil {
+			return diags
+		}
+	}
+
+	return readResource(ctx, d, meta)
 }
 
 // readResource is a function to read a new server.
-func readResource(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func readResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conf, ok := meta.(*helper.CombinedConfig)
 	if !ok {
 		return diag.Errorf("failed to convert meta object")
@@ -98,25 +108,55 @@ func readResource(_ context.Context, d *schema.ResourceData, meta any) diag.Diag
 
 	client := server.New(conf.Client)
 
-	resp, err := client.Get(context.Background(), server.GetRequest{
+	resp, err := client.Get(ctx, server.GetRequest{
 		ServerName: d.Id(),
 	})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] Server (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving server: %s", err)
 	}
 
 	if !resp.Status {
+		if helper.IsNotFoundMessage(resp.Msg) {
+			log.Printf("[WARN] Server (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving server: %s", resp.Msg)
 	}
 
-	if err := setServerAttributes(d, resp.Server); err != nil {
+	groups, diags := getSecurityGroups(ctx, conf, resp.Server.Name)
+	if diags != nil {
+		return diags
+	}
+
+	if err := setServerAttributes(d, resp.Server, groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setDefaultCommitDiskChanges(d); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// setDefaultCommitDiskChanges is a function to set commit_disk_changes to its default when it is missing from the state.
+// Defaults are not applied to servers in a state written before the attribute existed, so it is set to avoid a diff.
+func setDefaultCommitDiskChanges(d *schema.ResourceData) error {
+	if _, set := priorCommitDiskChanges(d); set || d.HasChange("commit_disk_changes") {
+		return nil
+	}
+
+	return d.Set("commit_disk_changes", true)
+}
+
 // updateResource is a function to update a server.
+// Every changed attribute is applied in order, waiting on each job, before the server is read back.
 func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conf, ok := meta.(*helper.CombinedConfig)
 	if !ok {
@@ -125,20 +165,110 @@ func updateResource(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	client := server.New(conf.Client)
 
+	// Keep the previous state if one of the steps fails, so the remaining changes are retried on the next apply.
+	d.Partial(true)
+
+	if d.HasChange("label") {
+		if diags := updateLabel(ctx, client, d); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChanges("product_code", "commit_disk_changes") {
+		if diags := updatePlan(ctx, conf, client, d); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("securitygroups") {
+		if diags := updateSecurityGroups(ctx, conf, d, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
+	var diags diag.Diagnostics
+	if d.HasChanges("ssh_keys", "ssh_key_ids") {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "SSH keys not updated on the server",
+			Detail: fmt.Sprintf("The SiteHost API can only deploy SSH keys when a server is created. The keys of the "+
+				"root user on server %q were not changed, update them on the server itself.", d.Id()),
+		})
+	}
+
+	d.Partial(false)
+
+	return append(diags, readResource(ctx, d, meta)...)
+}
+
+// updatePlan is a function to upgrade a server to the next plan and commit the disk changes when asked to.
+// Disk changes are committed after a plan change, or when commit_disk_changes is turned back on with changes pending.
+func updatePlan(ctx context.Context, conf *helper.CombinedConfig, client *server.Client, d *schema.ResourceData) diag.Diagnostics {
+	commit, ok := d.Get("commit_disk_changes").(bool)
+	if !ok {
+		return diag.Errorf("failed to convert commit_disk_changes to bool")
+	}
+
 	if d.HasChange("product_code") {
-		return upgradePlan(conf, client, d)
+		if diags := upgradePlan(ctx, client, d); diags != nil {
+			return diags
+		}
+
+		if commit {
+			return commitDiskChanges(ctx, conf, client, d)
+		}
+
+		return nil
 	}
 
-	if d.HasChange("label") {
-		return updateLabel(client, d)
+	if prior, set := priorCommitDiskChanges(d); commit && set && !prior && pendingDiskChanges(d) {
+		return commitDiskChanges(ctx, conf, client, d)
 	}
 
-	return readResource(ctx, d, meta)
+	return nil
 }
 
-// upgradePlan is a function to upgrade and commit a server to the next plan.
-func upgradePlan(conf *helper.CombinedConfig, client *server.Client, d *schema.ResourceData) diag.Diagnostics {
-	res, err := client.Upgrade(context.Background(), server.UpgradeRequest{
+// priorCommitDiskChanges is a function to get commit_disk_changes from the prior state, and whether it was set.
+// It is not set in a state written before the attribute existed, a new server has no prior state and counts as set.
+func priorCommitDiskChanges(d *schema.ResourceData) (commit bool, set bool) {
+	state := d.GetRawState()
+	if state.IsNull() || !state.IsKnown() {
+		return false, true
+	}
+
+	v := state.GetAttr("commit_disk_changes")
+	if v.IsNull() || !v.IsKnown() {
+		return false, false
+	}
+
+	return v.True(), true
+}
+
+// pendingDiskChanges is a function to check if any partition of a server has a disk change waiting to be committed.
+func pendingDiskChanges(d *schema.ResourceData) bool {
+	partitions, ok := d.Get("partitions").([]any)
+	if !ok {
+		return false
+	}
+
+	for _, v := range partitions {
+		p, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if newSize := fmt.Sprint(p["new_size"]); newSize != "" && newSize != fmt.Sprint(p["size"]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// upgradePlan is a function to upgrade a server to the next plan.
+// The disks keep their size until the disk changes are committed.
+func upgradePlan(ctx context.Context, client *server.Client, d *schema.ResourceData) diag.Diagnostics {
+	res, err := client.Upgrade(ctx, server.UpgradeRequest{
 		Name: d.Id(),
 		Plan: fmt.Sprint(d.Get("product_code")),
 	})
@@ -150,27 +280,32 @@ func upgradePlan(conf *helper.CombinedConfig, client *server.Client, d *schema.R
 		return diag.Errorf("Error upgrading server: %s", res.Msg)
 	}
 
-	resp, err := client.CommitDiskChanges(context.Background(), server.CommitDiskChangesRequest{
+	return nil
+}
+
+// commitDiskChanges is a function to commit the pending disk changes of a server.
+func commitDiskChanges(ctx context.Context, conf *helper.CombinedConfig, client *server.Client, d *schema.ResourceData) diag.Diagnostics {
+	resp, err := client.CommitDiskChanges(ctx, server.CommitDiskChangesRequest{
 		ServerName: d.Id(),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.Errorf("Error committing server disk changes: %s", err)
 	}
 
-	if !res.Status {
-		return diag.Errorf("Error upgrading server: %s", res.Msg)
+	if !resp.Status {
+		return diag.Errorf("Error committing server disk changes: %s", resp.Msg)
 	}
 
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(resp.Return.Job.ID), fmt.Sprint(resp.Return.Job.Type)); err != nil {
-		return diag.FromErr(err)
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(resp.Return.Job.ID), fmt.Sprint(resp.Return.Job.Type), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return helper.JobDiagnostics("Error committing server disk changes", err)
 	}
 
 	return nil
 }
 
 // updateLabel is a function to update a label of a server.
-func updateLabel(client *server.Client, d *schema.ResourceData) diag.Diagnostics {
-	res, err := client.Update(context.Background(), server.UpdateRequest{
+func updateLabel(ctx context.Context, client *server.Client, d *schema.ResourceData) diag.Diagnostics {
+	res, err := client.Update(ctx, server.UpdateRequest{
 		Name:  d.Id(),
 		Label: fmt.Sprint(d.Get("label")),
 	})
@@ -185,8 +320,99 @@ func updateLabel(client *server.Client, d *schema.ResourceData) diag.Diagnostics
 	return nil
 }
 
+// getSSHKeys is a function to get the SSH public keys to deploy, resolving the keys given by ID.
+func getSSHKeys(ctx context.Context, conf *helper.CombinedConfig, d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	keys, ok := d.Get("ssh_keys").([]any)
+	if !ok {
+		return nil, diag.Errorf("failed to convert ssh keys object")
+	}
+
+	ids, ok := d.Get("ssh_key_ids").([]any)
+	if !ok {
+		return nil, diag.Errorf("failed to convert ssh key ids object")
+	}
+
+	sshKeys := make([]string, 0, len(keys)+len(ids))
+	for _, key := range keys {
+		sshKeys = append(sshKeys, fmt.Sprint(key))
+	}
+
+	client := sshkey.New(conf.Client)
+	for _, id := range ids {
+		res, err := client.Get(ctx, sshkey.GetRequest{
+			ID: fmt.Sprint(id),
+		})
+		if err != nil {
+			return nil, diag.Errorf("Error getting ssh key: %s", err)
+		}
+
+		if !res.Status {
+			return nil, diag.Errorf("Error getting ssh key: %s", res.Msg)
+		}
+
+		sshKeys = append(sshKeys, res.Return.Content)
+	}
+
+	return sshKeys, nil
+}
+
+// updateSecurityGroups is a function to apply the security groups to the firewall of a server.
+func updateSecurityGroups(ctx context.Context, conf *helper.CombinedConfig, d *schema.ResourceData, timeout time.Duration) diag.Diagnostics {
+	list, ok := d.Get("securitygroups").([]any)
+	if !ok {
+		return diag.Errorf("failed to convert security groups object")
+	}
+
+	groups := make([]string, 0, len(list))
+	for _, v := range list {
+		groups = append(groups, fmt.Sprint(v))
+	}
+
+	res, err := firewall.New(conf.Client).Update(ctx, firewall.UpdateRequest{
+		ServerName:     d.Id(),
+		SecurityGroups: groups,
+	})
+	if err != nil {
+		return diag.Errorf("Error updating server firewall: %s", err)
+	}
+
+	if !res.Status {
+		return diag.Errorf("Error updating server firewall: %s", res.Msg)
+	}
+
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(res.Return.Job.ID), res.Return.Job.Type, timeout); err != nil {
+		return helper.JobDiagnostics("Error updating server firewall", err)
+	}
+
+	return nil
+}
+
+// clearSecurityGroups is a function to plan the removal of all security groups when `securitygroups` is set to an empty list.
+// An empty list is otherwise treated as unset, as the attribute is also computed.
+func clearSecurityGroups(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	groups := config.GetAttr("securitygroups")
+	if groups.IsNull() || !groups.IsKnown() || groups.LengthInt() != 0 {
+		return nil
+	}
+
+	if list, ok := d.Get("securitygroups").([]any); ok && len(list) == 0 {
+		return nil
+	}
+
+	return d.SetNew("securitygroups", []string{})
+}
+
 // deleteResource is a function to delete a server.
-func deleteResource(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func deleteResource(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conf, ok := meta.(*helper.CombinedConfig)
 	if !ok {
 		return diag.Errorf("failed to convert meta object")
@@ -194,7 +420,7 @@ func deleteResource(_ context.Context, d *schema.ResourceData, meta any) diag.Di
 
 	client := server.New(conf.Client)
 
-	resp, err := client.Delete(context.Background(), server.DeleteRequest{
+	resp, err := client.Delete(ctx, server.DeleteRequest{
 		Name: d.Id(),
 	})
 	if err != nil {
@@ -205,14 +431,85 @@ func deleteResource(_ context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diag.Errorf("Error deleting server: %s", resp.Msg)
 	}
 
-	if err := helper.WaitForAction(conf.Client, fmt.Sprint(resp.Return.Job.ID), fmt.Sprint(resp.Return.Job.Type)); err != nil {
-		return diag.FromErr(err)
+	if err := helper.WaitForAction(ctx, conf.Client, fmt.Sprint(resp.Return.Job.ID), fmt.Sprint(resp.Return.Job.Type), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return helper.JobDiagnostics("Error deleting server", err)
 	}
 
 	return nil
 }
 
+// importResource is a function to import a server by name.
+func importResource(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	// Defaults are not applied on import, set it so the first plan does not commit disk changes.
+	if err := d.Set("commit_disk_changes", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // setServerAttributes is a function to set data to a server.
-func setServerAttributes(d *schema.ResourceData, server models.Server) error {
-	return d.Set("name", server.Name)
+func setServerAttributes(d *schema.ResourceData, s models.Server, groups []string) error {
+	attributes := serverAttributes(s)
+	attributes["securitygroups"] = groups
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// suppressImportedImage is a function to ignore the image of an imported server.
+// The API only returns the distribution, not the image code the server was deployed from, so an
+// imported server has no image in its state and comparing it with the configuration would replace the server.
+func suppressImportedImage(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
+
+// serverAttributes is a function to map a server to the attributes shared by the resource and data sources.
+func serverAttributes(s models.Server) map[string]any {
+	return map[string]any{
+		"name":         s.Name,
+		"label":        s.Label,
+		"location":     s.LocationCode,
+		"product_code": s.ProductCode,
+		"ips":          serverIPs(s, ipv4),
+		"ipv6":         serverIPs(s, ipv6),
+		"state":        s.State,
+		"disk":         int(s.Disk),
+		"created":      s.Created,
+		"partitions":   serverPartitions(s),
+	}
+}
+
+// serverPartitions is a function to get the disk partitions of a server.
+func serverPartitions(s models.Server) []map[string]any {
+	partitions := make([]map[string]any, len(s.Partitions))
+	for i, p := range s.Partitions {
+		partitions[i] = map[string]any{
+			"name":       p.Name,
+			"device":     p.Device,
+			"mountpoint": p.Mountpoint,
+			"fstype":     p.Fstype,
+			"size":       p.Size,
+			"new_size":   p.NewSize,
+		}
+	}
+
+	return partitions
+}
+
+// serverIPs is a function to get the IP addresses of a server for the given address family.
+func serverIPs(s models.Server, family int) []string {
+	ips := make([]string, 0, len(s.Ips))
+	for _, ip := range s.Ips {
+		if ip.AddrFamily == family {
+			ips = append(ips, ip.IPAddr)
+		}
+	}
+
+	return ips
 }
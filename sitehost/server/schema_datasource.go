@@ -1,37 +1,155 @@
 package server
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 // serverDataSourceSchema is the schema with values for a Server DataSource.
 func serverDataSourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: "The `name` is the ID and is provided for a Server.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"name", "label"},
+			Description:  "The `name` is the ID and is provided for a Server.",
 		},
 		"label": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Each Server is assigned a single public IPv4 address upon creation.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"name", "label"},
+			Description:  "The SiteHost's label of the Server. When used to look up a Server, the label must be unique.",
 		},
 		"location": {
 			Type:     schema.TypeString,
-			Required: true,
+			Computed: true,
 			Description: "This is the location where the Server was deployed. This cannot be changed without " +
 				"opening a support ticket.",
 		},
 		"product_code": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The product code of the server to be deployed, determining the price and size.",
+			Computed:    true,
+			Description: "The product code of the server, determining the price and size.",
 		},
 		"image": {
-			Type:     schema.TypeString,
-			Required: true,
-			Description: "An Image ID to deploy the Disk from. The complete list of images ID you can see " +
-				"in our official documentation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The distribution image the Server is running.",
+		},
+		"ips": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The public IPv4 addresses assigned to the Server.",
+		},
+		"ipv6": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The IPv6 addresses assigned to the Server.",
+		},
+		"disk": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The total disk size of the Server.",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date/time when the Server was created.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The current state of the Server.",
+		},
+		"partitions": partitionsSchema(),
+		"securitygroups": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The security groups which this server uses.",
+		},
+	}
+}
+
+// serversDataSourceSchema is the schema with values for a Servers DataSource.
+func serversDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "A regular expression the label of the Servers must match.",
+		},
+		"location": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return the Servers deployed in this location.",
+		},
+		"product_code": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return the Servers with this product code.",
+		},
+		"servers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The `name` is the ID of the Server.",
+					},
+					"label": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The SiteHost's label of the Server.",
+					},
+					"location": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The location where the Server was deployed.",
+					},
+					"product_code": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The product code of the server, determining the price and size.",
+					},
+					"ips": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The public IPv4 addresses assigned to the Server.",
+					},
+					"ipv6": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The IPv6 addresses assigned to the Server.",
+					},
+					"disk": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The total disk size of the Server.",
+					},
+					"created": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date/time when the Server was created.",
+					},
+					"state": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The current state of the Server.",
+					},
+					"partitions": partitionsSchema(),
+				},
+			},
+			Description: "The Servers matching all of the given filters.",
 		},
 	}
 }
@@ -45,14 +45,17 @@ var resourceSchema = map[string]*schema.Schema{
 		Type:        schema.TypeList,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Optional:    true,
-		Description: "The security groups which this server uses.",
+		Computed:    true,
+		Description: "The security groups applied to the firewall of this server. The groups are applied once the server is built, and an empty list removes all groups. Leaving it unset keeps the current groups. Do not use together with `sitehost_server_firewall`.",
 	},
 	"image": {
-		Type:     schema.TypeString,
-		Required: true,
-		ForceNew: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressImportedImage,
 		Description: "An Image ID to deploy the Disk from. The complete list of images ID you can see " +
-			"in our official documentation.",
+			"in our official documentation. The API does not return the image a Server was deployed from, so " +
+			"it is not known for imported Servers and changing it does not replace them.",
 	},
 	"ssh_keys": {
 		Type:        schema.TypeList,
@@ -60,4 +63,83 @@ var resourceSchema = map[string]*schema.Schema{
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "A list of SSH public keys to deploy for the root user on the newly created Server.",
 	},
+	"ssh_key_ids": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "A list of `sitehost_ssh_key` IDs whose public keys are deployed for the root user on the newly " +
+			"created Server, in addition to `ssh_keys`.",
+	},
+	"ipv6": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The IPv6 addresses assigned to the Server.",
+	},
+	"state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The current state of the Server.",
+	},
+	"commit_disk_changes": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		Description: "Whether the disk changes of a plan change are committed straight away. When `false` the disks " +
+			"keep their size until this is set back to `true` or the changes are committed in the Control Panel.",
+	},
+	"partitions": partitionsSchema(),
+	"disk": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The total disk size of the Server.",
+	},
+	"created": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date/time when the Server was created.",
+	},
+}
+
+// partitionsSchema is the schema with the computed disk partitions of a Server.
+func partitionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the partition.",
+				},
+				"device": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The device of the partition.",
+				},
+				"mountpoint": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Where the partition is mounted.",
+				},
+				"fstype": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The filesystem type of the partition.",
+				},
+				"size": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The current size of the partition.",
+				},
+				"new_size": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The size of the partition once the pending disk changes are committed.",
+				},
+			},
+		},
+		Description: "The disk partitions of the Server.",
+	}
 }
@@ -114,10 +114,20 @@ func readResource(_ context.Context, d *schema.ResourceData, meta any) diag.Diag
 		ID: d.Id(),
 	})
 	if err != nil {
+		if helper.IsNotFound(err) {
+			log.Printf("[WARN] SSH Key (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving SSH Key: %s", err)
 	}
 
 	if !resp.Status {
+		if helper.IsNotFoundMessage(resp.Msg) {
+			log.Printf("[WARN] SSH Key (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("Error retrieving SSH Key: %s", resp.Msg)
 	}
 